@@ -0,0 +1,166 @@
+package status
+
+//go:generate go run ../../main.go -type currency -getter -parse switch
+
+// currency is an ISO 4217 currency with its numeric code, a large enum with sparse values
+// to benchmark the switch lookups of Parse and GetByID
+type currency uint16
+
+const (
+	currencyNone currency = 0
+	currencyAED  currency = 784
+	currencyAFN  currency = 971
+	currencyALL  currency = 8
+	currencyAMD  currency = 51
+	currencyANG  currency = 532
+	currencyAOA  currency = 973
+	currencyARS  currency = 32
+	currencyAUD  currency = 36
+	currencyAWG  currency = 533
+	currencyAZN  currency = 944
+	currencyBAM  currency = 977
+	currencyBBD  currency = 52
+	currencyBDT  currency = 50
+	currencyBGN  currency = 975
+	currencyBHD  currency = 48
+	currencyBIF  currency = 108
+	currencyBMD  currency = 60
+	currencyBND  currency = 96
+	currencyBOB  currency = 68
+	currencyBRL  currency = 986
+	currencyBSD  currency = 44
+	currencyBTN  currency = 64
+	currencyBWP  currency = 72
+	currencyBYN  currency = 933
+	currencyBZD  currency = 84
+	currencyCAD  currency = 124
+	currencyCDF  currency = 976
+	currencyCHF  currency = 756
+	currencyCLP  currency = 152
+	currencyCNY  currency = 156
+	currencyCOP  currency = 170
+	currencyCRC  currency = 188
+	currencyCUP  currency = 192
+	currencyCVE  currency = 132
+	currencyCZK  currency = 203
+	currencyDJF  currency = 262
+	currencyDKK  currency = 208
+	currencyDOP  currency = 214
+	currencyDZD  currency = 12
+	currencyEGP  currency = 818
+	currencyERN  currency = 232
+	currencyETB  currency = 230
+	currencyEUR  currency = 978
+	currencyFJD  currency = 242
+	currencyFKP  currency = 238
+	currencyGBP  currency = 826
+	currencyGEL  currency = 981
+	currencyGHS  currency = 936
+	currencyGIP  currency = 292
+	currencyGMD  currency = 270
+	currencyGNF  currency = 324
+	currencyGTQ  currency = 320
+	currencyGYD  currency = 328
+	currencyHKD  currency = 344
+	currencyHNL  currency = 340
+	currencyHTG  currency = 332
+	currencyHUF  currency = 348
+	currencyIDR  currency = 360
+	currencyILS  currency = 376
+	currencyINR  currency = 356
+	currencyIQD  currency = 368
+	currencyIRR  currency = 364
+	currencyISK  currency = 352
+	currencyJMD  currency = 388
+	currencyJOD  currency = 400
+	currencyJPY  currency = 392
+	currencyKES  currency = 404
+	currencyKGS  currency = 417
+	currencyKHR  currency = 116
+	currencyKMF  currency = 174
+	currencyKPW  currency = 408
+	currencyKRW  currency = 410
+	currencyKWD  currency = 414
+	currencyKYD  currency = 136
+	currencyKZT  currency = 398
+	currencyLAK  currency = 418
+	currencyLBP  currency = 422
+	currencyLKR  currency = 144
+	currencyLRD  currency = 430
+	currencyLSL  currency = 426
+	currencyLYD  currency = 434
+	currencyMAD  currency = 504
+	currencyMDL  currency = 498
+	currencyMGA  currency = 969
+	currencyMKD  currency = 807
+	currencyMMK  currency = 104
+	currencyMNT  currency = 496
+	currencyMOP  currency = 446
+	currencyMRU  currency = 929
+	currencyMUR  currency = 480
+	currencyMVR  currency = 462
+	currencyMWK  currency = 454
+	currencyMXN  currency = 484
+	currencyMYR  currency = 458
+	currencyMZN  currency = 943
+	currencyNAD  currency = 516
+	currencyNGN  currency = 566
+	currencyNIO  currency = 558
+	currencyNOK  currency = 578
+	currencyNPR  currency = 524
+	currencyNZD  currency = 554
+	currencyOMR  currency = 512
+	currencyPAB  currency = 590
+	currencyPEN  currency = 604
+	currencyPGK  currency = 598
+	currencyPHP  currency = 608
+	currencyPKR  currency = 586
+	currencyPLN  currency = 985
+	currencyPYG  currency = 600
+	currencyQAR  currency = 634
+	currencyRON  currency = 946
+	currencyRSD  currency = 941
+	currencyRUB  currency = 643
+	currencyRWF  currency = 646
+	currencySAR  currency = 682
+	currencySBD  currency = 90
+	currencySCR  currency = 690
+	currencySDG  currency = 938
+	currencySEK  currency = 752
+	currencySGD  currency = 702
+	currencySHP  currency = 654
+	currencySLE  currency = 925
+	currencySOS  currency = 706
+	currencySRD  currency = 968
+	currencySSP  currency = 728
+	currencySTN  currency = 930
+	currencySVC  currency = 222
+	currencySYP  currency = 760
+	currencySZL  currency = 748
+	currencyTHB  currency = 764
+	currencyTJS  currency = 972
+	currencyTMT  currency = 934
+	currencyTND  currency = 788
+	currencyTOP  currency = 776
+	currencyTRY  currency = 949
+	currencyTTD  currency = 780
+	currencyTWD  currency = 901
+	currencyTZS  currency = 834
+	currencyUAH  currency = 980
+	currencyUGX  currency = 800
+	currencyUSD  currency = 840
+	currencyUYU  currency = 858
+	currencyUZS  currency = 860
+	currencyVES  currency = 928
+	currencyVND  currency = 704
+	currencyVUV  currency = 548
+	currencyWST  currency = 882
+	currencyXAF  currency = 950
+	currencyXCD  currency = 951
+	currencyXOF  currency = 952
+	currencyXPF  currency = 953
+	currencyYER  currency = 886
+	currencyZAR  currency = 710
+	currencyZMW  currency = 967
+	currencyZWL  currency = 932
+)
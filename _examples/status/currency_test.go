@@ -0,0 +1,93 @@
+package status
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+	for in, want := range map[string]Currency{
+		"usd":      CurrencyUSD,
+		"USD":      CurrencyUSD,
+		"Usd":      CurrencyUSD,
+		"uSd":      CurrencyUSD,
+		"\u212AZT": CurrencyKZT, // KELVIN SIGN folds to "k", as strings.ToLower of the map mode does
+		"\u017FEK": CurrencySEK, // LATIN SMALL LETTER LONG S folds to "s"
+	} {
+		got, err := ParseCurrency(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "us", "usdx", "KZX"} {
+		_, err := ParseCurrency(in)
+		assert.Error(t, err, in)
+	}
+
+	for _, c := range CurrencyValues {
+		got, err := GetCurrencyByID(c.Index())
+		require.NoError(t, err)
+		assert.Equal(t, c, got)
+	}
+	_, err := GetCurrencyByID(1)
+	assert.Error(t, err)
+}
+
+// BenchmarkGetCurrencyByID compares the generated switch on sparse integer values, compiled to a binary
+// search, with a map lookup and the linear scan it replaces
+func BenchmarkGetCurrencyByID(b *testing.B) {
+	ids := make([]uint16, 0, len(CurrencyValues))
+	byID := make(map[uint16]Currency, len(CurrencyValues))
+	for _, c := range CurrencyValues {
+		ids = append(ids, c.Index())
+		byID[c.Index()] = c
+	}
+	b.Run("switch", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; b.Loop(); i++ {
+			_, _ = GetCurrencyByID(ids[i%len(ids)])
+		}
+	})
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; b.Loop(); i++ {
+			_ = byID[ids[i%len(ids)]]
+		}
+	})
+	b.Run("linear", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; b.Loop(); i++ {
+			id := ids[i%len(ids)]
+			for _, c := range CurrencyValues {
+				if c.Index() == id {
+					break
+				}
+			}
+		}
+	})
+}
+
+// BenchmarkParseCurrency compares the generated switch of -parse switch with the map lookup of the default
+// parse mode, for names in lower, upper and mixed case
+func BenchmarkParseCurrency(b *testing.B) {
+	byName := make(map[string]Currency, len(CurrencyValues))
+	for _, c := range CurrencyValues {
+		byName[strings.ToLower(c.String())] = c
+	}
+	for _, name := range []string{"zwl", "ZWL", "Zwl"} {
+		b.Run("switch "+name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_, _ = ParseCurrency(name)
+			}
+		})
+		b.Run("map "+name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				_ = byName[strings.ToLower(name)]
+			}
+		})
+	}
+}
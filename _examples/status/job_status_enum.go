@@ -3,7 +3,9 @@ package status
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // JobStatus is the exported type for the enum
@@ -14,14 +16,88 @@ type JobStatus struct {
 
 func (e JobStatus) String() string { return e.name }
 
+// GoString implements fmt.GoStringer and returns the constant name, e.g. JobStatusUnknown, used by %#v
+func (e JobStatus) GoString() string {
+	switch e {
+	case JobStatusUnknown:
+		return "JobStatusUnknown"
+	case JobStatusActive:
+		return "JobStatusActive"
+	case JobStatusInactive:
+		return "JobStatusInactive"
+	case JobStatusBlocked:
+		return "JobStatusBlocked"
+	}
+	return fmt.Sprintf("JobStatus{name: %q, value: %d}", e.name, e.value)
+}
+
 // Index returns the underlying integer value
 func (e JobStatus) Index() uint8 { return e.value }
 
-// MarshalText implements encoding.TextMarshaler
+// Equal reports whether e and other are the same jobStatus value
+func (e JobStatus) Equal(other JobStatus) bool { return e == other }
+
+// EqualString reports whether s is the name or an alias of e, case-insensitively.
+// It matches the same strings as ParseJobStatus but doesn't allocate, for hot comparison paths.
+func (e JobStatus) EqualString(s string) bool {
+	if e.name == "" {
+		return false
+	}
+	if strings.EqualFold(s, e.name) {
+		return true
+	}
+	return false
+}
+
+// Ptr returns a pointer to a copy of e, for optional fields, e.g. JobStatusUnknown.Ptr()
+func (e JobStatus) Ptr() *JobStatus { return &e }
+
+// JobStatusFromPtr returns the value p points to, or def if p is nil
+func JobStatusFromPtr(p *JobStatus, def JobStatus) JobStatus {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Next returns the value declared after e and true. It returns e and false if e is the last value
+// or not one of JobStatusValues.
+func (e JobStatus) Next() (JobStatus, bool) {
+	i := _jobStatusOrdinal(e)
+	if i < 0 || i+1 == len(JobStatusValues) {
+		return e, false
+	}
+	return JobStatusValues[i+1], true
+}
+
+// Prev returns the value declared before e and true. It returns e and false if e is the first value
+// or not one of JobStatusValues.
+func (e JobStatus) Prev() (JobStatus, bool) {
+	i := _jobStatusOrdinal(e)
+	if i <= 0 {
+		return e, false
+	}
+	return JobStatusValues[i-1], true
+}
+
+// MarshalText implements encoding.TextMarshaler. It returns the name from a precomputed table, so encoding
+// doesn't allocate per value, the returned slice is shared and must not be modified.
 func (e JobStatus) MarshalText() ([]byte, error) {
+	if i := _jobStatusOrdinal(e); i >= 0 {
+		b := _jobStatusText[i]
+		return b[:len(b):len(b)], nil // appending to the slice copies it
+	}
 	return []byte(e.name), nil
 }
 
+// _jobStatusText holds names of the values as bytes in declaration order, for MarshalText
+var _jobStatusText = [...][]byte{
+	[]byte("unknown"),
+	[]byte("active"),
+	[]byte("inactive"),
+	[]byte("blocked"),
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler
 func (e *JobStatus) UnmarshalText(text []byte) error {
 	var err error
@@ -74,13 +150,12 @@ var _jobStatusParseMap = map[string]JobStatus{
 	"blocked":  JobStatusBlocked,
 }
 
-// ParseJobStatus converts string to jobStatus enum value
+// ParseJobStatus converts string to jobStatus enum value.
+// Parsing is always case-insensitive.
 func ParseJobStatus(v string) (JobStatus, error) {
-
-	if val, ok := _jobStatusParseMap[v]; ok {
+	if val, ok := _jobStatusParseMap[strings.ToLower(v)]; ok {
 		return val, nil
 	}
-
 	return JobStatus{}, fmt.Errorf("invalid jobStatus: %s", v)
 }
 
@@ -93,6 +168,101 @@ func MustJobStatus(v string) JobStatus {
 	return r
 }
 
+// ParseJobStatusAny converts a loosely typed input, e.g. from a map, CSV record or reflection, to jobStatus
+// enum value. Strings, byte slices and fmt.Stringer values are parsed by name like ParseJobStatus, integers
+// and whole floats, like numbers decoded from JSON into any, are looked up by value.
+func ParseJobStatusAny(v any) (JobStatus, error) {
+	switch v := v.(type) {
+	case JobStatus:
+		return v, nil
+	case string:
+		return ParseJobStatus(v)
+	case []byte:
+		return ParseJobStatus(string(v))
+	case int:
+		return _jobStatusFromNumber(int64(v))
+	case int8:
+		return _jobStatusFromNumber(int64(v))
+	case int16:
+		return _jobStatusFromNumber(int64(v))
+	case int32:
+		return _jobStatusFromNumber(int64(v))
+	case int64:
+		return _jobStatusFromNumber(v)
+	case uint:
+		return _jobStatusFromUnsigned(uint64(v))
+	case uint8:
+		return _jobStatusFromNumber(int64(v))
+	case uint16:
+		return _jobStatusFromNumber(int64(v))
+	case uint32:
+		return _jobStatusFromNumber(int64(v))
+	case uint64:
+		return _jobStatusFromUnsigned(v)
+	case float64:
+		if n := int64(v); float64(n) == v {
+			return _jobStatusFromNumber(n)
+		}
+		return JobStatus{}, fmt.Errorf("invalid jobStatus value: %v", v)
+	case fmt.Stringer:
+		return ParseJobStatus(v.String())
+	}
+	return JobStatus{}, fmt.Errorf("invalid jobStatus: unsupported type %T", v)
+}
+
+// ParseJobStatusSlice converts names separated by sep, e.g. "unknown,active" with ",", to values in the same order,
+// for query parameters and environment variables. Names are trimmed, empty ones are skipped, and errors of all
+// invalid names are joined.
+func ParseJobStatusSlice(s, sep string) ([]JobStatus, error) {
+	var res []JobStatus
+	var errs []error
+	for _, part := range strings.Split(s, sep) {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		v, err := ParseJobStatus(part)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res = append(res, v)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid jobStatus list %q: %w", s, errors.Join(errs...))
+	}
+	return res, nil
+}
+
+// JobStatusJoin returns names of the values separated by sep, the reverse of ParseJobStatusSlice
+func JobStatusJoin(values []JobStatus, sep string) string {
+	var sb strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(v.String())
+	}
+	return sb.String()
+}
+
+// _jobStatusFromNumber returns the jobStatus value with the numeric value n
+func _jobStatusFromNumber(n int64) (JobStatus, error) {
+	for _, v := range JobStatusValues {
+		if int64(v.value) == n {
+			return v, nil
+		}
+	}
+	return JobStatus{}, fmt.Errorf("invalid jobStatus value: %d", n)
+}
+
+// _jobStatusFromUnsigned returns the jobStatus value with the numeric value n, which may not fit in int64
+func _jobStatusFromUnsigned(n uint64) (JobStatus, error) {
+	if n > 1<<63-1 {
+		return JobStatus{}, fmt.Errorf("invalid jobStatus value: %d", n)
+	}
+	return _jobStatusFromNumber(int64(n))
+}
+
 // GetJobStatusByID gets the correspondent jobStatus enum value by its ID (raw integer value)
 func GetJobStatusByID(v uint8) (JobStatus, error) {
 	switch v {
@@ -132,6 +302,21 @@ var JobStatusNames = []string{
 	"blocked",
 }
 
+// JobStatusCount is the number of jobStatus values
+const JobStatusCount = 4
+
+// JobStatusFirst returns the first declared jobStatus value
+func JobStatusFirst() JobStatus { return JobStatusUnknown }
+
+// JobStatusLast returns the last declared jobStatus value
+func JobStatusLast() JobStatus { return JobStatusBlocked }
+
+// MinJobStatus returns the jobStatus value with the smallest numeric value
+func MinJobStatus() JobStatus { return JobStatusUnknown }
+
+// MaxJobStatus returns the jobStatus value with the largest numeric value
+func MaxJobStatus() JobStatus { return JobStatusBlocked }
+
 // JobStatusIter returns a function compatible with Go 1.23's range-over-func syntax.
 // It yields all JobStatus values in declaration order. Example:
 //
@@ -148,6 +333,24 @@ func JobStatusIter() func(yield func(JobStatus) bool) {
 	}
 }
 
+// _jobStatusOrdinal returns the position of the value in JobStatusValues, or -1 if not found
+func _jobStatusOrdinal(v JobStatus) int {
+	if v.name == "" {
+		return -1 // zero JobStatus{} is not a valid key
+	}
+	switch v.value {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return 2
+	case 3:
+		return 3
+	}
+	return -1
+}
+
 // These variables are used to prevent the compiler from reporting unused errors
 // for the original enum constants. They are intentionally placed in a var block
 // that is compiled away by the Go compiler.
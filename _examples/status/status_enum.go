@@ -3,7 +3,9 @@ package status
 
 import (
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // Status is the exported type for the enum
@@ -14,14 +16,88 @@ type Status struct {
 
 func (e Status) String() string { return e.name }
 
+// GoString implements fmt.GoStringer and returns the constant name, e.g. StatusUnknown, used by %#v
+func (e Status) GoString() string {
+	switch e {
+	case StatusUnknown:
+		return "StatusUnknown"
+	case StatusActive:
+		return "StatusActive"
+	case StatusInactive:
+		return "StatusInactive"
+	case StatusBlocked:
+		return "StatusBlocked"
+	}
+	return fmt.Sprintf("Status{name: %q, value: %d}", e.name, e.value)
+}
+
 // Index returns the underlying integer value
 func (e Status) Index() uint8 { return e.value }
 
-// MarshalText implements encoding.TextMarshaler
+// Equal reports whether e and other are the same status value
+func (e Status) Equal(other Status) bool { return e == other }
+
+// EqualString reports whether s is the name or an alias of e, case-insensitively.
+// It matches the same strings as ParseStatus but doesn't allocate, for hot comparison paths.
+func (e Status) EqualString(s string) bool {
+	if e.name == "" {
+		return false
+	}
+	if strings.EqualFold(s, e.name) {
+		return true
+	}
+	return false
+}
+
+// Ptr returns a pointer to a copy of e, for optional fields, e.g. StatusUnknown.Ptr()
+func (e Status) Ptr() *Status { return &e }
+
+// StatusFromPtr returns the value p points to, or def if p is nil
+func StatusFromPtr(p *Status, def Status) Status {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Next returns the value declared after e and true. It returns e and false if e is the last value
+// or not one of StatusValues.
+func (e Status) Next() (Status, bool) {
+	i := _statusOrdinal(e)
+	if i < 0 || i+1 == len(StatusValues) {
+		return e, false
+	}
+	return StatusValues[i+1], true
+}
+
+// Prev returns the value declared before e and true. It returns e and false if e is the first value
+// or not one of StatusValues.
+func (e Status) Prev() (Status, bool) {
+	i := _statusOrdinal(e)
+	if i <= 0 {
+		return e, false
+	}
+	return StatusValues[i-1], true
+}
+
+// MarshalText implements encoding.TextMarshaler. It returns the name from a precomputed table, so encoding
+// doesn't allocate per value, the returned slice is shared and must not be modified.
 func (e Status) MarshalText() ([]byte, error) {
+	if i := _statusOrdinal(e); i >= 0 {
+		b := _statusText[i]
+		return b[:len(b):len(b)], nil // appending to the slice copies it
+	}
 	return []byte(e.name), nil
 }
 
+// _statusText holds names of the values as bytes in declaration order, for MarshalText
+var _statusText = [...][]byte{
+	[]byte("unknown"),
+	[]byte("active"),
+	[]byte("inactive"),
+	[]byte("blocked"),
+}
+
 // UnmarshalText implements encoding.TextUnmarshaler
 func (e *Status) UnmarshalText(text []byte) error {
 	var err error
@@ -74,13 +150,12 @@ var _statusParseMap = map[string]Status{
 	"blocked":  StatusBlocked,
 }
 
-// ParseStatus converts string to status enum value
+// ParseStatus converts string to status enum value.
+// Parsing is always case-insensitive.
 func ParseStatus(v string) (Status, error) {
-
-	if val, ok := _statusParseMap[v]; ok {
+	if val, ok := _statusParseMap[strings.ToLower(v)]; ok {
 		return val, nil
 	}
-
 	return Status{}, fmt.Errorf("invalid status: %s", v)
 }
 
@@ -93,6 +168,101 @@ func MustStatus(v string) Status {
 	return r
 }
 
+// ParseStatusAny converts a loosely typed input, e.g. from a map, CSV record or reflection, to status
+// enum value. Strings, byte slices and fmt.Stringer values are parsed by name like ParseStatus, integers
+// and whole floats, like numbers decoded from JSON into any, are looked up by value.
+func ParseStatusAny(v any) (Status, error) {
+	switch v := v.(type) {
+	case Status:
+		return v, nil
+	case string:
+		return ParseStatus(v)
+	case []byte:
+		return ParseStatus(string(v))
+	case int:
+		return _statusFromNumber(int64(v))
+	case int8:
+		return _statusFromNumber(int64(v))
+	case int16:
+		return _statusFromNumber(int64(v))
+	case int32:
+		return _statusFromNumber(int64(v))
+	case int64:
+		return _statusFromNumber(v)
+	case uint:
+		return _statusFromUnsigned(uint64(v))
+	case uint8:
+		return _statusFromNumber(int64(v))
+	case uint16:
+		return _statusFromNumber(int64(v))
+	case uint32:
+		return _statusFromNumber(int64(v))
+	case uint64:
+		return _statusFromUnsigned(v)
+	case float64:
+		if n := int64(v); float64(n) == v {
+			return _statusFromNumber(n)
+		}
+		return Status{}, fmt.Errorf("invalid status value: %v", v)
+	case fmt.Stringer:
+		return ParseStatus(v.String())
+	}
+	return Status{}, fmt.Errorf("invalid status: unsupported type %T", v)
+}
+
+// ParseStatusSlice converts names separated by sep, e.g. "unknown,active" with ",", to values in the same order,
+// for query parameters and environment variables. Names are trimmed, empty ones are skipped, and errors of all
+// invalid names are joined.
+func ParseStatusSlice(s, sep string) ([]Status, error) {
+	var res []Status
+	var errs []error
+	for _, part := range strings.Split(s, sep) {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		v, err := ParseStatus(part)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res = append(res, v)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid status list %q: %w", s, errors.Join(errs...))
+	}
+	return res, nil
+}
+
+// StatusJoin returns names of the values separated by sep, the reverse of ParseStatusSlice
+func StatusJoin(values []Status, sep string) string {
+	var sb strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(v.String())
+	}
+	return sb.String()
+}
+
+// _statusFromNumber returns the status value with the numeric value n
+func _statusFromNumber(n int64) (Status, error) {
+	for _, v := range StatusValues {
+		if int64(v.value) == n {
+			return v, nil
+		}
+	}
+	return Status{}, fmt.Errorf("invalid status value: %d", n)
+}
+
+// _statusFromUnsigned returns the status value with the numeric value n, which may not fit in int64
+func _statusFromUnsigned(n uint64) (Status, error) {
+	if n > 1<<63-1 {
+		return Status{}, fmt.Errorf("invalid status value: %d", n)
+	}
+	return _statusFromNumber(int64(n))
+}
+
 // Public constants for status values
 var (
 	StatusUnknown  = Status{name: "unknown", value: 0}
@@ -117,6 +287,21 @@ var StatusNames = []string{
 	"blocked",
 }
 
+// StatusCount is the number of status values
+const StatusCount = 4
+
+// StatusFirst returns the first declared status value
+func StatusFirst() Status { return StatusUnknown }
+
+// StatusLast returns the last declared status value
+func StatusLast() Status { return StatusBlocked }
+
+// MinStatus returns the status value with the smallest numeric value
+func MinStatus() Status { return StatusUnknown }
+
+// MaxStatus returns the status value with the largest numeric value
+func MaxStatus() Status { return StatusBlocked }
+
 // StatusIter returns a function compatible with Go 1.23's range-over-func syntax.
 // It yields all Status values in declaration order. Example:
 //
@@ -133,6 +318,24 @@ func StatusIter() func(yield func(Status) bool) {
 	}
 }
 
+// _statusOrdinal returns the position of the value in StatusValues, or -1 if not found
+func _statusOrdinal(v Status) int {
+	if v.name == "" {
+		return -1 // zero Status{} is not a valid key
+	}
+	switch v.value {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return 2
+	case 3:
+		return 3
+	}
+	return -1
+}
+
 // These variables are used to prevent the compiler from reporting unused errors
 // for the original enum constants. They are intentionally placed in a var block
 // that is compiled away by the Go compiler.
@@ -121,6 +121,15 @@ func TestStatus(t *testing.T) {
 		assert.Equal(t, StatusValues[:2], collected)
 	})
 
+	t.Run("equal", func(t *testing.T) {
+		assert.True(t, StatusActive.Equal(MustStatus("active")))
+		assert.False(t, StatusActive.Equal(StatusBlocked))
+		assert.True(t, StatusActive.EqualString("active"))
+		assert.True(t, StatusActive.EqualString("ACTIVE"))
+		assert.False(t, StatusActive.EqualString("blocked"))
+		assert.False(t, Status{}.EqualString(""))
+	})
+
 	t.Run("invalid", func(t *testing.T) {
 		var d struct {
 			Status Status `json:"status"`
@@ -159,3 +168,20 @@ func ExampleStatusIter() {
 	// all statuses: 4
 	// first two statuses: unknown active
 }
+
+func BenchmarkStatusEqualString(b *testing.B) {
+	s := StatusBlocked
+	b.Run("naive", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			p, err := ParseStatus("BLOCKED")
+			_ = err == nil && p == s
+		}
+	})
+	b.Run("equal", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = s.EqualString("BLOCKED")
+		}
+	})
+}
@@ -0,0 +1,173 @@
+// Package enumcore holds the generic helpers of enums generated with the -generic flag. The generated code
+// keeps its types and constants, declares a Table per type with New, and reduces parsing, marshaling and
+// iteration to one-line instantiations of the helpers here, e.g. enumcore.Parse(_statusTable, v).
+package enumcore
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Value is a value of a generated enum type, named by String
+type Value interface {
+	comparable
+	String() string
+}
+
+// Config describes a generated enum type E for New
+type Config[E Value] struct {
+	Type    string       // name of the type in errors, e.g. "status"
+	Values  []E          // values in declaration order
+	Aliases map[string]E // aliases accepted by parsing, in addition to the names
+	Hidden  []E          // values left out of Names, e.g. deprecated ones
+	Wrap    bool         // Next and Prev wrap around from the last value to the first one and back
+}
+
+// Table is the data of a generated enum type E. It's built once per type by New and is read-only after,
+// the helpers return copies of its slices.
+type Table[E Value] struct {
+	typ     string
+	values  []E
+	names   []string
+	wrap    bool
+	ordinal map[E]int    // position of each value in values
+	parse   map[string]E // lower-cased names and aliases
+	text    [][]byte     // names of the values as bytes, for MarshalText
+}
+
+// New returns the table of the config. It's called once per type by the generated code, at init.
+func New[E Value](cfg Config[E]) *Table[E] {
+	t := &Table[E]{
+		typ:     cfg.Type,
+		values:  slices.Clone(cfg.Values),
+		names:   make([]string, 0, len(cfg.Values)),
+		wrap:    cfg.Wrap,
+		ordinal: make(map[E]int, len(cfg.Values)),
+		parse:   make(map[string]E, len(cfg.Values)+len(cfg.Aliases)),
+		text:    make([][]byte, len(cfg.Values)),
+	}
+	for i, v := range cfg.Values {
+		if _, dup := t.ordinal[v]; !dup {
+			t.ordinal[v] = i
+		}
+		t.parse[strings.ToLower(v.String())] = v
+		t.text[i] = []byte(v.String())
+		if !slices.Contains(cfg.Hidden, v) {
+			t.names = append(t.names, v.String())
+		}
+	}
+	for alias, v := range cfg.Aliases {
+		t.parse[strings.ToLower(alias)] = v
+	}
+	return t
+}
+
+// Parse returns the value with the name or alias s, case-insensitively
+func Parse[E Value](t *Table[E], s string) (E, error) {
+	if v, ok := Lookup(t, s); ok {
+		return v, nil
+	}
+	var zero E
+	return zero, fmt.Errorf("invalid %s: %s", t.typ, s)
+}
+
+// Lookup returns the value with the name or alias s, case-insensitively, and false if there is none
+func Lookup[E Value](t *Table[E], s string) (E, bool) {
+	v, ok := t.parse[strings.ToLower(s)]
+	return v, ok
+}
+
+// ParseSlice converts names separated by sep to values in the same order with parse, which may return
+// a fallback value for unknown names. Names are trimmed, empty ones are skipped, and errors of all invalid
+// names are joined.
+func ParseSlice[E Value](t *Table[E], s, sep string, parse func(string) (E, error)) ([]E, error) {
+	var res []E
+	var errs []error
+	for _, part := range strings.Split(s, sep) {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		v, err := parse(part)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res = append(res, v)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid %s list %q: %w", t.typ, s, errors.Join(errs...))
+	}
+	return res, nil
+}
+
+// Join returns names of the values separated by sep, the reverse of ParseSlice
+func Join[E Value](values []E, sep string) string {
+	var sb strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(v.String())
+	}
+	return sb.String()
+}
+
+// Values returns a copy of the values in declaration order
+func Values[E Value](t *Table[E]) []E { return slices.Clone(t.values) }
+
+// Names returns a copy of the names in declaration order, without the hidden values
+func Names[E Value](t *Table[E]) []string { return slices.Clone(t.names) }
+
+// Iter returns an iterator over the values in declaration order
+func Iter[E Value](t *Table[E]) iter.Seq[E] { return slices.Values(t.values) }
+
+// Ordinal returns the position of the value in declaration order, or -1 if v is not one of the values
+func Ordinal[E Value](t *Table[E], v E) int {
+	if i, ok := t.ordinal[v]; ok {
+		return i
+	}
+	return -1
+}
+
+// Next returns the value declared after v and true. It returns v and false if v is the last value,
+// unless the table wraps around, or not one of the values.
+func Next[E Value](t *Table[E], v E) (E, bool) {
+	i := Ordinal(t, v)
+	switch {
+	case i < 0:
+		return v, false
+	case i+1 < len(t.values):
+		return t.values[i+1], true
+	case t.wrap:
+		return t.values[0], true
+	}
+	return v, false
+}
+
+// Prev returns the value declared before v and true. It returns v and false if v is the first value,
+// unless the table wraps around, or not one of the values.
+func Prev[E Value](t *Table[E], v E) (E, bool) {
+	i := Ordinal(t, v)
+	switch {
+	case i < 0:
+		return v, false
+	case i > 0:
+		return t.values[i-1], true
+	case t.wrap:
+		return t.values[len(t.values)-1], true
+	}
+	return v, false
+}
+
+// MarshalText returns the name of v from a precomputed table, so encoding doesn't allocate per value,
+// the returned slice is shared and must not be modified
+func MarshalText[E Value](t *Table[E], v E) ([]byte, error) {
+	if i := Ordinal(t, v); i >= 0 {
+		b := t.text[i]
+		return b[:len(b):len(b)], nil // appending to the slice copies it
+	}
+	return []byte(v.String()), nil
+}
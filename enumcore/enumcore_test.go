@@ -0,0 +1,140 @@
+package enumcore
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type status struct {
+	name  string
+	value int
+}
+
+func (s status) String() string { return s.name }
+
+var (
+	statusUnknown = status{name: "unknown", value: 0}
+	statusActive  = status{name: "active", value: 1}
+	statusBlocked = status{name: "blocked", value: 2}
+)
+
+func newStatusTable(wrap bool) *Table[status] {
+	return New(Config[status]{
+		Type:    "status",
+		Values:  []status{statusUnknown, statusActive, statusBlocked},
+		Aliases: map[string]status{"On": statusActive},
+		Hidden:  []status{statusBlocked},
+		Wrap:    wrap,
+	})
+}
+
+func TestParse(t *testing.T) {
+	table := newStatusTable(false)
+	for _, s := range []string{"active", "Active", "ON"} {
+		v, err := Parse(table, s)
+		require.NoError(t, err, s)
+		assert.Equal(t, statusActive, v, s)
+	}
+	_, err := Parse(table, "deleted")
+	require.EqualError(t, err, "invalid status: deleted")
+
+	v, ok := Lookup(table, "BLOCKED")
+	assert.True(t, ok)
+	assert.Equal(t, statusBlocked, v, "hidden values are parsed")
+	_, ok = Lookup(table, "")
+	assert.False(t, ok)
+}
+
+func TestParseSliceAndJoin(t *testing.T) {
+	table := newStatusTable(false)
+	parse := func(s string) (status, error) { return Parse(table, s) }
+
+	got, err := ParseSlice(table, " active, ,Blocked ", ",", parse)
+	require.NoError(t, err)
+	assert.Equal(t, []status{statusActive, statusBlocked}, got)
+	assert.Equal(t, "active|blocked", Join(got, "|"))
+
+	_, err = ParseSlice(table, "active,foo,bar", ",", parse)
+	require.EqualError(t, err, "invalid status list \"active,foo,bar\": invalid status: foo\ninvalid status: bar")
+
+	fallback := func(s string) (status, error) {
+		if v, ok := Lookup(table, s); ok {
+			return v, nil
+		}
+		return statusUnknown, nil
+	}
+	got, err = ParseSlice(table, "active,foo", ",", fallback)
+	require.NoError(t, err)
+	assert.Equal(t, []status{statusActive, statusUnknown}, got)
+}
+
+func TestValuesAndNames(t *testing.T) {
+	table := newStatusTable(false)
+	assert.Equal(t, []status{statusUnknown, statusActive, statusBlocked}, Values(table))
+	assert.Equal(t, []string{"unknown", "active"}, Names(table), "hidden values are left out")
+
+	values, names := Values(table), Names(table)
+	values[0], names[0] = statusBlocked, "changed"
+	assert.Equal(t, statusUnknown, Values(table)[0], "values are copied")
+	assert.Equal(t, "unknown", Names(table)[0], "names are copied")
+
+	cfg := Config[status]{Type: "status", Values: []status{statusActive}}
+	cloned := New(cfg)
+	cfg.Values[0] = statusBlocked
+	assert.Equal(t, []status{statusActive}, Values(cloned), "values of the config are copied")
+}
+
+func TestIterate(t *testing.T) {
+	table := newStatusTable(false)
+	assert.Equal(t, []status{statusUnknown, statusActive, statusBlocked}, slices.Collect(Iter(table)))
+
+	var visited int
+	for range Iter(table) {
+		visited++
+		break
+	}
+	assert.Equal(t, 1, visited, "iteration stops on break")
+
+	assert.Equal(t, 1, Ordinal(table, statusActive))
+	assert.Equal(t, -1, Ordinal(table, status{}))
+
+	next, ok := Next(table, statusActive)
+	assert.True(t, ok)
+	assert.Equal(t, statusBlocked, next)
+	next, ok = Next(table, statusBlocked)
+	assert.False(t, ok)
+	assert.Equal(t, statusBlocked, next)
+	prev, ok := Prev(table, statusUnknown)
+	assert.False(t, ok)
+	assert.Equal(t, statusUnknown, prev)
+	_, ok = Prev(table, status{})
+	assert.False(t, ok)
+
+	wrapped := newStatusTable(true)
+	next, ok = Next(wrapped, statusBlocked)
+	assert.True(t, ok)
+	assert.Equal(t, statusUnknown, next)
+	prev, ok = Prev(wrapped, statusUnknown)
+	assert.True(t, ok)
+	assert.Equal(t, statusBlocked, prev)
+}
+
+func TestMarshalText(t *testing.T) {
+	table := newStatusTable(false)
+	text, err := MarshalText(table, statusActive)
+	require.NoError(t, err)
+	assert.Equal(t, "active", string(text))
+
+	text = append(text, "-x"...)
+	assert.Equal(t, "active-x", string(text))
+	again, err := MarshalText(table, statusActive)
+	require.NoError(t, err)
+	assert.Equal(t, "active", string(again), "appending doesn't change the shared table")
+
+	text, err = MarshalText(table, status{name: "other"})
+	require.NoError(t, err)
+	assert.Equal(t, "other", string(text))
+}
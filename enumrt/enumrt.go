@@ -0,0 +1,218 @@
+// Package enumrt is the shared runtime of enums generated with the -runtime flag. The generated code keeps
+// the types, constants and thin methods, and delegates parsing, marshaling and iteration to an Enum of this
+// package, so the logic isn't repeated in every generated file.
+package enumrt
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// Value is a value of a generated enum type with the underlying type U
+type Value[U comparable] interface {
+	comparable
+	String() string
+	Index() U
+}
+
+// Config describes a generated enum for New
+type Config[E Value[U], U comparable] struct {
+	TypeName  string       // name of the enum type, e.g. "Status"
+	ErrorName string       // name of the type in errors, e.g. "status"
+	Values    []E          // values in declaration order
+	GoNames   []string     // names of the public constants of the values, e.g. "StatusActive", for GoString
+	Aliases   map[string]E // aliases accepted by parsing, in addition to the names
+	Wrap      bool         // Next and Prev wrap around from the last value to the first one and back
+}
+
+// Enum implements the logic of a generated enum type E with the underlying type U
+type Enum[E Value[U], U comparable] struct {
+	cfg     Config[E, U]
+	ordinal map[E]int    // position of each value in cfg.Values
+	parse   map[string]E // lower-cased names and aliases
+	text    [][]byte     // names of the values as bytes, for MarshalText
+	aliases [][]string   // aliases of each value, for EqualString
+}
+
+// New returns the Enum for the config. It's called once per type by the generated code, at init.
+func New[E Value[U], U comparable](cfg Config[E, U]) *Enum[E, U] {
+	e := &Enum[E, U]{
+		cfg:     cfg,
+		ordinal: make(map[E]int, len(cfg.Values)),
+		parse:   make(map[string]E, len(cfg.Values)+len(cfg.Aliases)),
+		text:    make([][]byte, len(cfg.Values)),
+		aliases: make([][]string, len(cfg.Values)),
+	}
+	for i, v := range cfg.Values {
+		if _, dup := e.ordinal[v]; !dup {
+			e.ordinal[v] = i
+		}
+		e.parse[strings.ToLower(v.String())] = v
+		e.text[i] = []byte(v.String())
+	}
+	for alias, v := range cfg.Aliases {
+		e.parse[strings.ToLower(alias)] = v
+		if i, ok := e.ordinal[v]; ok {
+			e.aliases[i] = append(e.aliases[i], alias)
+		}
+	}
+	return e
+}
+
+// Values returns the values in declaration order, the slice is shared and must not be modified
+func (e *Enum[E, U]) Values() []E { return e.cfg.Values }
+
+// Ordinal returns the position of the value in declaration order, or -1 if v is not one of the values
+func (e *Enum[E, U]) Ordinal(v E) int {
+	if i, ok := e.ordinal[v]; ok {
+		return i
+	}
+	return -1
+}
+
+// Parse returns the value with the name or alias s, case-insensitively
+func (e *Enum[E, U]) Parse(s string) (E, error) {
+	if v, ok := e.parse[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	var zero E
+	return zero, fmt.Errorf("invalid %s: %s", e.cfg.ErrorName, s)
+}
+
+// Must is like Parse but panics if s is invalid
+func (e *Enum[E, U]) Must(s string) E {
+	v, err := e.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// ParseSlice converts names separated by sep to values in the same order. Names are trimmed, empty ones
+// are skipped, and errors of all invalid names are joined.
+func (e *Enum[E, U]) ParseSlice(s, sep string) ([]E, error) {
+	var res []E
+	var errs []error
+	for _, part := range strings.Split(s, sep) {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		v, err := e.Parse(part)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res = append(res, v)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid %s list %q: %w", e.cfg.ErrorName, s, errors.Join(errs...))
+	}
+	return res, nil
+}
+
+// Join returns names of the values separated by sep, the reverse of ParseSlice
+func (e *Enum[E, U]) Join(values []E, sep string) string {
+	var sb strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			sb.WriteString(sep)
+		}
+		sb.WriteString(v.String())
+	}
+	return sb.String()
+}
+
+// EqualString reports whether s is the name or an alias of v, case-insensitively, without allocations
+func (e *Enum[E, U]) EqualString(v E, s string) bool {
+	i := e.Ordinal(v)
+	if i < 0 {
+		return false
+	}
+	if strings.EqualFold(s, v.String()) {
+		return true
+	}
+	for _, alias := range e.aliases[i] {
+		if strings.EqualFold(s, alias) {
+			return true
+		}
+	}
+	return false
+}
+
+// GoString returns the name of the public constant of v, used by %#v
+func (e *Enum[E, U]) GoString(v E) string {
+	if i := e.Ordinal(v); i >= 0 && i < len(e.cfg.GoNames) {
+		return e.cfg.GoNames[i]
+	}
+	if raw, ok := any(v.Index()).(string); ok {
+		return fmt.Sprintf("%s{name: %q, value: %q}", e.cfg.TypeName, v.String(), raw)
+	}
+	return fmt.Sprintf("%s{name: %q, value: %v}", e.cfg.TypeName, v.String(), v.Index())
+}
+
+// MarshalText returns the name of v from a precomputed table, so encoding doesn't allocate per value,
+// the returned slice is shared and must not be modified
+func (e *Enum[E, U]) MarshalText(v E) ([]byte, error) {
+	if i := e.Ordinal(v); i >= 0 {
+		b := e.text[i]
+		return b[:len(b):len(b)], nil // appending to the slice copies it
+	}
+	return []byte(v.String()), nil
+}
+
+// Scan returns the value of a database column, a string or a byte slice with the name. NULL is scanned
+// to the value with the zero underlying value, an error if there is no such value.
+func (e *Enum[E, U]) Scan(src any) (E, error) {
+	var zero E
+	switch src := src.(type) {
+	case nil:
+		var raw U
+		for _, v := range e.cfg.Values {
+			if v.Index() == raw {
+				return v, nil
+			}
+		}
+		return zero, fmt.Errorf("cannot scan nil into %s: no zero value defined", e.cfg.TypeName)
+	case string:
+		return e.Parse(src)
+	case []byte:
+		return e.Parse(string(src))
+	}
+	return zero, fmt.Errorf("invalid %s value: %v", e.cfg.ErrorName, src)
+}
+
+// Next returns the value declared after v and true. It returns v and false if v is the last value,
+// unless the config wraps around, or not one of the values.
+func (e *Enum[E, U]) Next(v E) (E, bool) {
+	i := e.Ordinal(v)
+	switch {
+	case i < 0:
+		return v, false
+	case i+1 < len(e.cfg.Values):
+		return e.cfg.Values[i+1], true
+	case e.cfg.Wrap:
+		return e.cfg.Values[0], true
+	}
+	return v, false
+}
+
+// Prev returns the value declared before v and true. It returns v and false if v is the first value,
+// unless the config wraps around, or not one of the values.
+func (e *Enum[E, U]) Prev(v E) (E, bool) {
+	i := e.Ordinal(v)
+	switch {
+	case i < 0:
+		return v, false
+	case i > 0:
+		return e.cfg.Values[i-1], true
+	case e.cfg.Wrap:
+		return e.cfg.Values[len(e.cfg.Values)-1], true
+	}
+	return v, false
+}
+
+// All returns an iterator over the values in declaration order
+func (e *Enum[E, U]) All() iter.Seq[E] { return slices.Values(e.cfg.Values) }
@@ -0,0 +1,115 @@
+package enumrt
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// status mimics a generated enum type
+type status struct {
+	name  string
+	value uint8
+}
+
+func (s status) String() string { return s.name }
+func (s status) Index() uint8   { return s.value }
+
+var (
+	statusUnknown = status{name: "unknown", value: 0}
+	statusActive  = status{name: "active", value: 1}
+	statusBlocked = status{name: "blocked", value: 2}
+)
+
+func newStatusEnum(wrap bool) *Enum[status, uint8] {
+	return New(Config[status, uint8]{
+		TypeName:  "Status",
+		ErrorName: "status",
+		Values:    []status{statusUnknown, statusActive, statusBlocked},
+		GoNames:   []string{"StatusUnknown", "StatusActive", "StatusBlocked"},
+		Aliases:   map[string]status{"on": statusActive, "Banned": statusBlocked},
+		Wrap:      wrap,
+	})
+}
+
+func TestEnumParse(t *testing.T) {
+	e := newStatusEnum(false)
+	for s, want := range map[string]status{"active": statusActive, "ACTIVE": statusActive, "on": statusActive,
+		"banned": statusBlocked, "Unknown": statusUnknown} {
+		got, err := e.Parse(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, got, s)
+	}
+	_, err := e.Parse("deleted")
+	require.EqualError(t, err, "invalid status: deleted")
+	assert.PanicsWithError(t, "invalid status: deleted", func() { e.Must("deleted") })
+	assert.Equal(t, statusBlocked, e.Must("blocked"))
+
+	values, err := e.ParseSlice(" active,, blocked ", ",")
+	require.NoError(t, err)
+	assert.Equal(t, []status{statusActive, statusBlocked}, values)
+	assert.Equal(t, "active|blocked", e.Join(values, "|"))
+	_, err = e.ParseSlice("x,active,y", ",")
+	require.EqualError(t, err, "invalid status list \"x,active,y\": invalid status: x\ninvalid status: y")
+
+	assert.True(t, e.EqualString(statusActive, "On"))
+	assert.True(t, e.EqualString(statusActive, "Active"))
+	assert.False(t, e.EqualString(statusBlocked, "on"))
+	assert.False(t, e.EqualString(status{}, ""))
+}
+
+func TestEnumMarshal(t *testing.T) {
+	e := newStatusEnum(false)
+	text, err := e.MarshalText(statusBlocked)
+	require.NoError(t, err)
+	assert.Equal(t, "blocked", string(text))
+	assert.Len(t, text, cap(text), "appending doesn't modify the shared table")
+
+	assert.Equal(t, "StatusActive", e.GoString(statusActive))
+	assert.Equal(t, `Status{name: "", value: 0}`, e.GoString(status{}))
+
+	for src, want := range map[any]status{nil: statusUnknown, "active": statusActive} {
+		got, err := e.Scan(src)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	got, err := e.Scan([]byte("blocked"))
+	require.NoError(t, err)
+	assert.Equal(t, statusBlocked, got)
+	_, err = e.Scan(42)
+	require.EqualError(t, err, "invalid status value: 42")
+
+	noZero := New(Config[status, uint8]{TypeName: "Status", ErrorName: "status", Values: []status{statusActive}})
+	_, err = noZero.Scan(nil)
+	require.EqualError(t, err, "cannot scan nil into Status: no zero value defined")
+}
+
+func TestEnumIterate(t *testing.T) {
+	e := newStatusEnum(false)
+	assert.Equal(t, []status{statusUnknown, statusActive, statusBlocked}, slices.Collect(e.All()))
+	assert.Equal(t, 1, e.Ordinal(statusActive))
+	assert.Equal(t, -1, e.Ordinal(status{}))
+	assert.Len(t, e.Values(), 3)
+
+	next, ok := e.Next(statusActive)
+	assert.True(t, ok)
+	assert.Equal(t, statusBlocked, next)
+	next, ok = e.Next(statusBlocked)
+	assert.False(t, ok)
+	assert.Equal(t, statusBlocked, next)
+	prev, ok := e.Prev(statusUnknown)
+	assert.False(t, ok)
+	assert.Equal(t, statusUnknown, prev)
+	_, ok = e.Prev(status{})
+	assert.False(t, ok)
+
+	wrapped := newStatusEnum(true)
+	next, ok = wrapped.Next(statusBlocked)
+	assert.True(t, ok)
+	assert.Equal(t, statusUnknown, next)
+	prev, ok = wrapped.Prev(statusUnknown)
+	assert.True(t, ok)
+	assert.Equal(t, statusBlocked, prev)
+}
@@ -0,0 +1,14 @@
+// Command enum-exhaustive reports switches on enums generated by go-pkgz/enum missing some of the values.
+// It runs standalone on package patterns, e.g. enum-exhaustive ./..., or as a vet tool:
+//
+//	go build -o /tmp/enum-exhaustive github.com/go-pkgz/enum/exhaustive/cmd/enum-exhaustive
+//	go vet -vettool=/tmp/enum-exhaustive ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/go-pkgz/enum/exhaustive"
+)
+
+func main() { singlechecker.Main(exhaustive.Analyzer) }
@@ -0,0 +1,249 @@
+// Package exhaustive defines an analyzer reporting switches on enums generated by go-pkgz/enum which handle
+// some of the values only and have no default clause, so a value added to the enum is silently ignored there.
+//
+// Enums are identified by type: the values are the package-level variables of the enum type declared in the file
+// written by the generator, e.g. StatusActive of Status, and switches on the source type, status, match its constants
+// by value.
+// The values are exported as facts, so switches in other packages are checked as well, and case expressions
+// are resolved with type information, e.g. status.StatusActive matches only if status is the package of the
+// enum. The analyzer runs with go vet -vettool, see cmd/enum-exhaustive, or with any analysis driver.
+package exhaustive
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer reports switches on generated enums missing some of the values without a default clause.
+// The -type flag limits the check to one enum, e.g. -type status.
+var Analyzer = newAnalyzer()
+
+// newAnalyzer returns the analyzer with its flags
+func newAnalyzer() *analysis.Analyzer {
+	a := &analysis.Analyzer{
+		Name: "exhaustive",
+		Doc: "check that switches on enums generated by go-pkgz/enum handle all values\n\n" +
+			"A switch on an enum type with cases for some of the values and no default clause is reported\n" +
+			"with the missing values, a default clause marks the remaining values as handled on purpose.",
+		URL:       "https://github.com/go-pkgz/enum#exhaustive-switches",
+		FactTypes: []analysis.Fact{new(enumFact)},
+	}
+	typeName := a.Flags.String("type", "", "check only switches on the enum of this type, e.g. status, all enums if empty")
+	a.Run = func(pass *analysis.Pass) (any, error) { return run(pass, *typeName) }
+	return a
+}
+
+// generatedComment is the first line of the code written by the generator, it may follow a custom header
+const generatedComment = "// Code generated by enum generator; DO NOT EDIT."
+
+// enumFact is attached to the exported enum type and to its underlying type, listing the values
+// in declaration order
+type enumFact struct {
+	Values []enumValue
+}
+
+// enumValue is a value of the enum
+type enumValue struct {
+	Var   string // exported variable holding the value, e.g. StatusActive
+	Const string // exact constant value of the underlying type, e.g. "1" or `"active"`
+}
+
+// AFact marks enumFact as an analysis fact
+func (*enumFact) AFact() {}
+
+// String returns the values of the enum, for fact dumps and tests
+func (f *enumFact) String() string {
+	names := make([]string, 0, len(f.Values))
+	for _, v := range f.Values {
+		names = append(names, v.Var)
+	}
+	return "enum(" + strings.Join(names, ", ") + ")"
+}
+
+// run exports the enums declared in the package as facts and checks switches on enums,
+// of the type only if typeName is set
+func run(pass *analysis.Pass, typeName string) (any, error) {
+	for _, file := range pass.Files {
+		if generatedByEnum(file) {
+			exportEnums(pass, file)
+		}
+	}
+
+	for _, file := range pass.Files {
+		if ast.IsGenerated(file) {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if sw, ok := n.(*ast.SwitchStmt); ok && sw.Tag != nil {
+				checkSwitch(pass, sw, typeName)
+			}
+			return true
+		})
+	}
+	return nil, nil
+}
+
+// generatedByEnum reports whether the file was written by the generator
+func generatedByEnum(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+		for _, c := range group.List {
+			if c.Text == generatedComment {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// exportEnums finds the values declared in the generated file, e.g. StatusActive = Status{name: "Active",
+// value: 1}, and exports them as facts of the enum type and of the source type the constants are declared with
+func exportEnums(pass *analysis.Pass, file *ast.File) {
+	var order []*types.TypeName
+	facts := make(map[*types.TypeName]*enumFact)
+
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vspec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vspec.Names) != len(vspec.Values) {
+				continue
+			}
+			for i, name := range vspec.Names {
+				enum, raw, ok := enumValueOf(pass, name, vspec.Values[i])
+				if !ok {
+					continue
+				}
+				if facts[enum] == nil {
+					order = append(order, enum)
+					facts[enum] = &enumFact{}
+				}
+				facts[enum].Values = append(facts[enum].Values, enumValue{Var: name.Name, Const: raw.ExactString()})
+			}
+		}
+	}
+
+	for _, enum := range order {
+		pass.ExportObjectFact(enum, facts[enum])
+		if src := sourceType(pass.Pkg, enum); src != nil {
+			pass.ExportObjectFact(src, facts[enum])
+		}
+	}
+}
+
+// sourceType returns the type the constants of the enum are declared with, status for Status,
+// or for statusEnum generated with -unexported, nil if it's not in the package
+func sourceType(pkg *types.Package, enum *types.TypeName) *types.TypeName {
+	name := enum.Name()
+	for _, candidate := range []string{strings.ToLower(name[:1]) + name[1:], strings.TrimSuffix(name, "Enum")} {
+		tn, ok := pkg.Scope().Lookup(candidate).(*types.TypeName)
+		if ok && tn != enum {
+			if _, basic := tn.Type().Underlying().(*types.Basic); basic {
+				return tn
+			}
+		}
+	}
+	return nil
+}
+
+// enumValueOf checks if the variable is a value of a generated enum, a package-level variable of a struct
+// type of the package initialized with a literal of the type, and returns the type and the constant value field
+func enumValueOf(pass *analysis.Pass, name *ast.Ident, init ast.Expr) (*types.TypeName, constant.Value, bool) {
+	obj, ok := pass.TypesInfo.Defs[name].(*types.Var)
+	if !ok || obj.Parent() != pass.Pkg.Scope() {
+		return nil, nil, false
+	}
+	named, ok := types.Unalias(obj.Type()).(*types.Named)
+	if !ok || named.Obj().Pkg() != pass.Pkg {
+		return nil, nil, false
+	}
+	lit, ok := ast.Unparen(init).(*ast.CompositeLit)
+	if !ok || !types.Identical(pass.TypesInfo.TypeOf(lit), named) {
+		return nil, nil, false
+	}
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "value" {
+			raw := pass.TypesInfo.Types[kv.Value].Value
+			return named.Obj(), raw, raw != nil
+		}
+	}
+	return nil, nil, false
+}
+
+// checkSwitch reports the switch if its tag is an enum and the cases miss some of the values
+func checkSwitch(pass *analysis.Pass, sw *ast.SwitchStmt, typeName string) {
+	named, ok := types.Unalias(pass.TypesInfo.TypeOf(sw.Tag)).(*types.Named)
+	if !ok || (typeName != "" && !strings.EqualFold(named.Obj().Name(), typeName)) {
+		return
+	}
+	fact := new(enumFact)
+	if !pass.ImportObjectFact(named.Obj(), fact) {
+		return
+	}
+
+	covered := make([]bool, len(fact.Values))
+	matched := false
+	for _, stmt := range sw.Body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		if clause.List == nil {
+			return // default clause handles the rest
+		}
+		for _, e := range clause.List {
+			for i, v := range fact.Values {
+				if matchCase(pass, e, named, v) {
+					covered[i], matched = true, true
+				}
+			}
+		}
+	}
+	if !matched {
+		return // no case is a value of the enum, e.g. a switch on computed values
+	}
+
+	var missing []string
+	for i, v := range fact.Values {
+		if !covered[i] {
+			missing = append(missing, v.Var)
+		}
+	}
+	if len(missing) > 0 {
+		msg := fmt.Sprintf("switch on %s misses %s, add cases or a default clause", named.Obj().Name(), strings.Join(missing, ", "))
+		pass.Report(analysis.Diagnostic{Pos: sw.Pos(), End: sw.Body.Lbrace, Message: msg})
+	}
+}
+
+// matchCase checks if the case expression is the value: a constant with its value for the underlying type,
+// or its variable, declared in the package of the enum, for the exported type
+func matchCase(pass *analysis.Pass, e ast.Expr, enum *types.Named, v enumValue) bool {
+	if tv := pass.TypesInfo.Types[e]; tv.Value != nil {
+		return tv.Value.ExactString() == v.Const
+	}
+	var id *ast.Ident
+	switch expr := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		id = expr
+	case *ast.SelectorExpr:
+		id = expr.Sel
+	default:
+		return false
+	}
+	obj, ok := pass.TypesInfo.Uses[id].(*types.Var)
+	return ok && obj.Name() == v.Var && obj.Pkg() != nil && obj.Pkg().Path() == enum.Obj().Pkg().Path()
+}
@@ -0,0 +1,25 @@
+package exhaustive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "status", "app", "role")
+}
+
+func TestAnalyzerType(t *testing.T) {
+	a := newAnalyzer()
+	require.NoError(t, a.Flags.Set("type", "role"))
+	res := analysistest.Run(&discard{}, analysistest.TestData(), a, "status")
+	require.Len(t, res, 1)
+	require.Empty(t, res[0].Diagnostics, "switches on status are not checked with -type role")
+}
+
+// discard is analysistest.Testing ignoring unmatched expectations
+type discard struct{}
+
+func (*discard) Errorf(string, ...any) {}
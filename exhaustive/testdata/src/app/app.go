@@ -0,0 +1,35 @@
+package app
+
+import (
+	"other"
+	"status"
+)
+
+func handle(s status.Status) string {
+	switch s { // want `switch on Status misses StatusBlocked, add cases or a default clause`
+	case status.StatusUnknown, status.StatusActive:
+		return "active"
+	case status.StatusInactive, other.StatusBlocked:
+		return "inactive"
+	}
+
+	switch s {
+	case status.StatusUnknown, status.StatusActive, status.StatusInactive, status.StatusBlocked:
+		return "all"
+	}
+
+	switch s {
+	case status.StatusActive:
+		return "active"
+	default:
+		return "other"
+	}
+}
+
+func unrelated(n int) string {
+	switch n {
+	case 1:
+		return "one"
+	}
+	return ""
+}
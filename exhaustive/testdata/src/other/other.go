@@ -0,0 +1,6 @@
+package other
+
+import "status"
+
+// StatusBlocked has the name of a status value but is declared in another package
+var StatusBlocked = status.StatusActive
@@ -0,0 +1,25 @@
+package role
+
+type role string // want role:"roleEnumAdmin, roleEnumEditor, roleEnumViewer"
+
+const (
+	roleAdmin  role = "admin"
+	roleEditor role = "editor"
+	roleViewer role = "viewer"
+)
+
+func canEdit(r role) bool {
+	switch r { // want `switch on role misses roleEnumViewer, add cases or a default clause`
+	case roleAdmin, roleEditor:
+		return true
+	}
+	return false
+}
+
+func label(r roleEnum) string {
+	switch r { // want `switch on roleEnum misses roleEnumAdmin, add cases or a default clause`
+	case roleEnumEditor, roleEnumViewer:
+		return "user"
+	}
+	return ""
+}
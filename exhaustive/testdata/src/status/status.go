@@ -0,0 +1,32 @@
+package status
+
+type status uint8 // want status:"StatusUnknown, StatusActive, StatusInactive, StatusBlocked"
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusInactive
+	statusBlocked
+)
+
+func describe(s status) string {
+	switch s { // want `switch on status misses StatusBlocked, add cases or a default clause`
+	case statusUnknown:
+		return "unknown"
+	case statusActive, statusInactive:
+		return "known"
+	}
+	return ""
+}
+
+func public(s Status) string {
+	switch s { // want `switch on Status misses StatusInactive, StatusBlocked, add cases or a default clause`
+	case StatusUnknown, StatusActive:
+		return "first"
+	}
+	switch s.Index() {
+	case 1:
+		return "computed"
+	}
+	return ""
+}
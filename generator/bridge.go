@@ -0,0 +1,39 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// SetCrossPackage enables or disables generation of the enum into another package than the one declaring
+// the private constants, set with the output path. The generated code declares the values by their literals
+// and doesn't reference the constants, the guard against unused constants goes to a bridge file,
+// <type>_enum_bridge.go, written next to them.
+func (g *Generator) SetCrossPackage(v bool) { g.crossPackage = v }
+
+// getBridgeFileName returns the bridge file name for the generated file, e.g. "status_enum_bridge.go"
+func getBridgeFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_bridge.go"
+}
+
+// bridgeDir returns the absolute source directory the bridge file is written to, validating the output
+// path is another directory
+func (g *Generator) bridgeDir() (string, error) {
+	if g.Path == "" || g.srcDir == "" {
+		return "", errors.New("cross-package requires an output path in another directory than the source")
+	}
+	src, err := filepath.Abs(g.srcDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve source directory: %w", err)
+	}
+	out, err := filepath.Abs(g.Path)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve output directory: %w", err)
+	}
+	if src == out {
+		return "", errors.New("cross-package requires an output path in another directory than the source")
+	}
+	return src, nil
+}
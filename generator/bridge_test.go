@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCrossPackage(t *testing.T) {
+	src := `package domain
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive
+)
+`
+	root := t.TempDir()
+	srcDir, outDir := filepath.Join(root, "domain"), filepath.Join(root, "api")
+	require.NoError(t, os.Mkdir(srcDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("jobStatus", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.SetOption("cross-package", "true"))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "package api\n")
+	assert.Contains(t, string(content), "JobStatusActive  = JobStatus{name: \"Active\", value: 1}")
+	assert.NotContains(t, string(content), "jobStatusActive", "constants of the source package aren't referenced")
+
+	bridge, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum_bridge.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(bridge), "package domain\n")
+	assert.Contains(t, string(bridge), "var _ jobStatus = jobStatusActive\n")
+	assert.NotContains(t, string(bridge), "JobStatus")
+
+	stale, err := gen.Check()
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+
+	gen.SetGuard("var")
+	require.NoError(t, gen.Generate())
+	bridge, err = os.ReadFile(filepath.Join(srcDir, "job_status_enum_bridge.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(bridge), "\t_ = jobStatusActive\n")
+
+	gen.SetGuard("none")
+	require.NoError(t, gen.Generate())
+	assert.NoFileExists(t, filepath.Join(srcDir, "job_status_enum_bridge.go"), "bridge without a guard is removed")
+
+	t.Run("same directory", func(t *testing.T) {
+		for _, path := range []string{"", srcDir} {
+			g, err := New("jobStatus", path)
+			require.NoError(t, err)
+			require.NoError(t, g.Parse(srcDir))
+			g.SetCrossPackage(true)
+			assert.EqualError(t, g.Generate(), "cross-package requires an output path in another directory than the source")
+		}
+	})
+}
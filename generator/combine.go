@@ -0,0 +1,203 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"slices"
+	"strings"
+)
+
+// GenerateCombined generates code for several enum types of one package into a single file, instead of
+// a file per type. Imports are shared and the guards against unused constants are merged into one block.
+// Files generated for the types separately before are removed. All generators must be parsed, have the
+// same output path and must not split features into separate files.
+func GenerateCombined(name string, gens ...*Generator) error {
+	if err := checkFileName("combined file", name); err != nil {
+		return err
+	}
+	src, extra, stale, err := renderCombined(gens)
+	if err != nil {
+		return err
+	}
+	stale = slices.DeleteFunc(stale, func(s string) bool { return s == name })
+	files := append([]generatedFile{{name: name, src: src}}, extra...)
+	if err := keepCustomRegions(gens[0].Path, files); err != nil {
+		return err
+	}
+	return gens[0].writeFiles(files, stale)
+}
+
+// CheckCombined is like Generator.Check for the combined file of GenerateCombined
+func CheckCombined(name string, gens ...*Generator) ([]string, error) {
+	if err := checkFileName("combined file", name); err != nil {
+		return nil, err
+	}
+	src, extra, stale, err := renderCombined(gens)
+	if err != nil {
+		return nil, err
+	}
+	stale = slices.DeleteFunc(stale, func(s string) bool { return s == name })
+	files := append([]generatedFile{{name: name, src: src}}, extra...)
+	if err := keepCustomRegions(gens[0].Path, files); err != nil {
+		return nil, err
+	}
+	return checkFiles(gens[0].Path, files, stale)
+}
+
+// GenerateCombinedTo is like GenerateCombined but writes the combined code to w instead of a file
+func GenerateCombinedTo(w io.Writer, gens ...*Generator) error {
+	src, _, _, err := renderCombined(gens)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(src); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
+
+// renderCombined renders the combined source of all types and returns it with non-Go files of the types,
+// like JSON schemas, and the names of files generated for the types separately
+func renderCombined(gens []*Generator) (src []byte, extra []generatedFile, stale []string, err error) {
+	if len(gens) == 0 {
+		return nil, nil, nil, errors.New("no types to generate")
+	}
+
+	sources := make([][]byte, 0, len(gens))
+	for _, g := range gens {
+		if g.splitFiles {
+			return nil, nil, nil, fmt.Errorf("split is not supported for combined generation, type %s", g.Type)
+		}
+		if g.Path != gens[0].Path {
+			return nil, nil, nil, fmt.Errorf("output path of type %s differs from type %s", g.Type, gens[0].Type)
+		}
+		files, featureFiles, err := g.render()
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("type %s: %w", g.Type, err)
+		}
+		sources = append(sources, files[0].src)
+		extra = append(extra, files[1:]...) // split is not allowed, the rest are test files and non-Go files
+		stale = append(stale, featureFiles...)
+		stale = append(stale, files[0].name)
+	}
+
+	if src, err = mergeSources(sources); err != nil {
+		return nil, nil, nil, err
+	}
+	types := make([]string, 0, len(gens))
+	for _, g := range gens {
+		types = append(types, g.Type)
+	}
+	header, err := gens[0].renderHeader(strings.Join(types, ", "))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return slices.Concat(header, src), extra, stale, nil
+}
+
+// mergeSources merges generated files of one package into one. Declarations are kept as is, in order,
+// imports are deduplicated and the statements of all unused-constant guards are moved to a single guard.
+func mergeSources(sources [][]byte) ([]byte, error) {
+	var pkgName string
+	var imports, body, guard []string
+	for _, src := range sources {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse generated source: %w", err)
+		}
+		if pkgName != "" && file.Name.Name != pkgName {
+			return nil, fmt.Errorf("package %s differs from %s", file.Name.Name, pkgName)
+		}
+		pkgName = file.Name.Name
+
+		offset := func(p token.Pos) int { return fset.Position(p).Offset }
+		start := offset(file.Name.End()) // declarations start after the package clause and imports
+		for _, spec := range file.Imports {
+			imp := string(src[offset(spec.Pos()):offset(spec.End())])
+			if !slices.Contains(imports, imp) {
+				imports = append(imports, imp)
+			}
+		}
+		for _, decl := range file.Decls {
+			if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT {
+				start = offset(gd.End())
+			}
+		}
+
+		end := len(src)
+		rest := ""
+		if gd, stmts := findGuard(file); gd != nil {
+			end = offset(gd.Pos())
+			if gd.Doc != nil {
+				end = offset(gd.Doc.Pos())
+			}
+			rest = string(src[offset(gd.End()):])
+			// statements without the final "return true", comments between them included
+			guard = append(guard, strings.TrimSpace(string(src[offset(stmts[0].Pos()):offset(stmts[len(stmts)-1].Pos())])))
+		}
+		body = append(body, string(src[start:end])+rest)
+	}
+	slices.Sort(imports)
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader + "\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	if len(imports) > 0 {
+		buf.WriteString("import (\n")
+		for _, imp := range imports {
+			buf.WriteString("\t" + imp + "\n")
+		}
+		buf.WriteString(")\n")
+	}
+	for _, b := range body {
+		buf.WriteString(b + "\n")
+	}
+	if len(guard) > 0 {
+		buf.WriteString("// These variables are used to prevent the compiler from reporting unused errors\n")
+		buf.WriteString("// for the original enum constants. They are intentionally placed in a var block\n")
+		buf.WriteString("// that is compiled away by the Go compiler.\n")
+		buf.WriteString("var _ = func() bool {\n")
+		for _, g := range guard {
+			buf.WriteString(g + "\n")
+		}
+		buf.WriteString("return true\n}()\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format combined source: %w", err)
+	}
+	return src, nil
+}
+
+// findGuard returns the "var _ = func() bool { ... }()" declaration guarding against unused constants
+// and the statements of its function, the last one being the return
+func findGuard(file *ast.File) (*ast.GenDecl, []ast.Stmt) {
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR || len(gd.Specs) != 1 {
+			continue
+		}
+		vspec, ok := gd.Specs[0].(*ast.ValueSpec)
+		if !ok || len(vspec.Names) != 1 || vspec.Names[0].Name != "_" || len(vspec.Values) != 1 {
+			continue
+		}
+		call, ok := vspec.Values[0].(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		fn, ok := call.Fun.(*ast.FuncLit)
+		if !ok || len(fn.Body.List) < 2 {
+			continue
+		}
+		return gd, fn.Body.List
+	}
+	return nil, nil
+}
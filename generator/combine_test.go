@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCombined(t *testing.T) {
+	newGen := func(t *testing.T, typeName, path string) *Generator {
+		t.Helper()
+		gen, err := New(typeName, path)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		return gen
+	}
+
+	t.Run("combined file", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		status, jobStatus := newGen(t, "status", tmpDir), newGen(t, "jobStatus", tmpDir)
+		status.SetGenerateSQL(true)
+
+		// files generated separately before are replaced
+		require.NoError(t, status.Generate())
+		require.NoError(t, jobStatus.Generate())
+
+		require.NoError(t, GenerateCombined("enums.go", status, jobStatus))
+		assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum.go"))
+		assert.NoFileExists(t, filepath.Join(tmpDir, "job_status_enum.go"))
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "enums.go"))
+		require.NoError(t, err)
+		src := string(content)
+		assert.True(t, strings.HasPrefix(src, generatedHeader+"\npackage enum\n"))
+		assert.Equal(t, 1, strings.Count(src, "import ("))
+		assert.Equal(t, 1, strings.Count(src, `"fmt"`))
+		assert.Contains(t, src, `"database/sql/driver"`)
+		assert.Less(t, strings.Index(src, "type Status struct"), strings.Index(src, "type JobStatus struct"))
+		assert.Equal(t, 1, strings.Count(src, "var _ = func() bool {"))
+		assert.Contains(t, src, "\tvar _ status = statusBlocked\n\tvar _ jobStatus = jobStatus(0)\n")
+	})
+
+	t.Run("to writer", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		var buf bytes.Buffer
+		require.NoError(t, GenerateCombinedTo(&buf, newGen(t, "status", tmpDir), newGen(t, "jobStatus", tmpDir)))
+		assert.Contains(t, buf.String(), "type Status struct")
+		assert.Contains(t, buf.String(), "type JobStatus struct")
+		assert.NoFileExists(t, filepath.Join(tmpDir, "enums.go"))
+	})
+
+	t.Run("check", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		status, jobStatus := newGen(t, "status", tmpDir), newGen(t, "jobStatus", tmpDir)
+		require.NoError(t, status.Generate())
+
+		res, err := CheckCombined("enums.go", status, jobStatus)
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(tmpDir, "enums.go") + ": missing",
+			filepath.Join(tmpDir, "status_enum.go") + ": stale, should be removed"}, res)
+
+		require.NoError(t, GenerateCombined("enums.go", status, jobStatus))
+		res, err = CheckCombined("enums.go", status, jobStatus)
+		require.NoError(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		require.EqualError(t, GenerateCombined("enums.go"), "no types to generate")
+
+		split := newGen(t, "status", tmpDir)
+		split.SetSplitFiles(true)
+		err := GenerateCombined("enums.go", split)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "split is not supported")
+
+		err = GenerateCombined("enums.go", newGen(t, "status", tmpDir), newGen(t, "jobStatus", t.TempDir()))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "output path of type jobStatus differs")
+
+		for name, want := range map[string]string{
+			"-lower":        `combined file "-lower" is not a .go file`,
+			"enums_test.go": `combined file "enums_test.go" is a test file`,
+			"gen/enums.go":  `combined file "gen/enums.go" is not a file name, set the directory with the output path`,
+			"enums.go.tmp":  `combined file "enums.go.tmp" is not a .go file`,
+		} {
+			require.EqualError(t, GenerateCombined(name, newGen(t, "status", tmpDir)), want)
+			_, err = CheckCombined(name, newGen(t, "status", tmpDir))
+			require.EqualError(t, err, want)
+		}
+		assert.NoFileExists(t, filepath.Join(tmpDir, "-lower"))
+	})
+}
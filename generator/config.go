@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config is the content of a generator configuration file. It defines named presets, sets of options
+// used the same way as in "enum:" directives, and the presets applied to each type, for example:
+//
+//	{
+//	  "presets": {
+//	    "api-enum": ["lower", "yaml"],
+//	    "db-enum": ["sql", "getter"]
+//	  },
+//	  "types": {
+//	    "status": ["api-enum"],
+//	    "jobStatus": ["db-enum", "lower=false"]
+//	  }
+//	}
+type Config struct {
+	Presets map[string][]string `json:"presets"` // preset name to options, e.g. "db-enum": ["sql", "getter"]
+	Types   map[string][]string `json:"types"`   // type name to presets or options applied to it, in order
+}
+
+// LoadConfig reads the configuration file and checks that all presets and options in it are known
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // config path is provided by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	// validate by applying everything to a throwaway generator
+	var errs []error
+	for _, name := range sortedKeys(cfg.Presets) {
+		if err := (&Generator{presets: cfg.Presets}).applyPreset(name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, typeName := range sortedKeys(cfg.Types) {
+		g := &Generator{Type: typeName}
+		if err := cfg.Apply(g); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid config %s: %w", path, errors.Join(errs...))
+	}
+	return &cfg, nil
+}
+
+// Apply makes the presets available to the generator and applies the presets and options
+// configured for its type. Entries of the type are either preset names or options.
+func (c *Config) Apply(g *Generator) error {
+	g.SetPresets(c.Presets)
+	var errs []error
+	for _, entry := range c.Types[g.Type] {
+		name, value, _ := strings.Cut(entry, "=")
+		if _, ok := c.Presets[entry]; ok {
+			name, value = "preset", entry
+		}
+		if err := g.SetOption(name, value); err != nil {
+			errs = append(errs, fmt.Errorf("type %s: %w", g.Type, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetPresets sets the named option presets which can be applied with the "preset" option
+func (g *Generator) SetPresets(presets map[string][]string) { g.presets = presets }
+
+// applyPreset applies all options of the named preset
+func (g *Generator) applyPreset(name string) error {
+	opts, ok := g.presets[name]
+	if !ok {
+		return fmt.Errorf("unknown preset %q", name)
+	}
+	for _, opt := range opts {
+		optName, value, _ := strings.Cut(opt, "=")
+		if optName == "preset" {
+			return fmt.Errorf("preset %s: nested presets are not supported", name)
+		}
+		if err := g.SetOption(optName, value); err != nil {
+			return fmt.Errorf("preset %s: %w", name, err)
+		}
+	}
+	return nil
+}
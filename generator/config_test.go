@@ -0,0 +1,69 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	writeConfig := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "enum.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		path := writeConfig(t, `{
+			"presets": {"api-enum": ["lower", "yaml"], "db-enum": ["sql", "getter"]},
+			"types": {"status": ["api-enum", "db-enum", "yaml=false"]}
+		}`)
+		cfg, err := LoadConfig(path)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sql", "getter"}, cfg.Presets["db-enum"])
+
+		gen, err := New("status", "")
+		require.NoError(t, err)
+		require.NoError(t, cfg.Apply(gen))
+		assert.True(t, gen.lowerCase)
+		assert.True(t, gen.generateSQL)
+		assert.True(t, gen.generateGetter)
+		assert.False(t, gen.generateYAML, "explicit option after preset overrides it")
+
+		// types not in config are left as is
+		other, err := New("other", "")
+		require.NoError(t, err)
+		require.NoError(t, cfg.Apply(other))
+		assert.False(t, other.lowerCase)
+
+		// presets can be used in type directives after Apply
+		require.NoError(t, other.SetOption("preset", "db-enum"))
+		assert.True(t, other.generateSQL)
+	})
+
+	tests := []struct {
+		name, content, err string
+	}{
+		{"missing file", "", "failed to read config"},
+		{"bad json", `{"presets": [}`, "failed to parse config"},
+		{"unknown option", `{"presets": {"api": ["lower", "frobnicate"]}}`, `preset api: unknown option "frobnicate"`},
+		{"bad value", `{"presets": {"api": ["lower=maybe"]}}`, `invalid value "maybe"`},
+		{"nested preset", `{"presets": {"a": ["lower"], "b": ["preset=a"]}}`, "nested presets are not supported"},
+		{"unknown preset", `{"types": {"status": ["db-enum"]}}`, `type status: unknown option "db-enum"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "missing.json")
+			if tt.content != "" {
+				path = writeConfig(t, tt.content)
+			}
+			_, err := LoadConfig(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+)
+
+const (
+	customBegin = "// enum:custom-begin" // starts a hand-written region of a generated file, kept by regeneration
+	customEnd   = "// enum:custom-end"   // ends the hand-written region
+)
+
+// customRegions holds hand-written regions of a generated Go file, with the marker lines
+type customRegions struct {
+	imports []string // regions inside the import block, e.g. imports used by the hand-written helpers
+	decls   []string // regions outside of the import block, with declarations
+}
+
+// keepCustomRegions carries hand-written regions between "// enum:custom-begin" and "// enum:custom-end"
+// lines from the Go files in dir over to the generated ones. Regions of the import block are put into
+// the new import block, imports it generates as well are not repeated, and other regions are appended
+// to the end of the generated code, in the order of the previous file.
+func keepCustomRegions(dir string, files []generatedFile) error {
+	for i, f := range files {
+		if !strings.HasSuffix(f.name, ".go") {
+			continue
+		}
+		path := outputPath(dir, f.name)
+		prev, err := os.ReadFile(path) //nolint:gosec // path is built from the output dir and type name
+		if err != nil || !bytes.Contains(prev, []byte(customBegin)) {
+			continue
+		}
+		regions, err := findCustomRegions(prev)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		src, err := regions.merge(f.src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		files[i].src = src
+	}
+	return nil
+}
+
+// findCustomRegions returns the hand-written regions of the Go source
+func findCustomRegions(src []byte) (customRegions, error) {
+	var res customRegions
+	var region []string
+	inImports, begin := false, 0
+	for n, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, customBegin):
+			if region != nil {
+				return customRegions{}, fmt.Errorf("custom region at line %d starts inside the region of line %d", n+1, begin)
+			}
+			region, begin = []string{line}, n+1
+		case strings.HasPrefix(trimmed, customEnd):
+			if region == nil {
+				return customRegions{}, fmt.Errorf("custom region end at line %d without a start", n+1)
+			}
+			text := strings.Join(append(region, line), "\n")
+			if inImports {
+				res.imports = append(res.imports, text)
+			} else {
+				res.decls = append(res.decls, text)
+			}
+			region = nil
+		case region != nil:
+			region = append(region, line)
+		case trimmed == "import (":
+			inImports = true
+		case inImports && trimmed == ")":
+			inImports = false
+		}
+	}
+	if region != nil {
+		return customRegions{}, fmt.Errorf("custom region at line %d is not closed with %q", begin, customEnd)
+	}
+	return res, nil
+}
+
+// merge returns the generated source with the regions, formatted
+func (r customRegions) merge(src []byte) ([]byte, error) {
+	lines := strings.Split(string(src), "\n")
+	if len(r.imports) > 0 {
+		kept := make(map[string]bool)
+		for _, region := range r.imports {
+			for _, line := range strings.Split(region, "\n") {
+				kept[strings.TrimSpace(line)] = true
+			}
+		}
+		var res []string
+		inImports, found := false, false
+		for _, line := range lines {
+			trimmed := strings.TrimSpace(line)
+			switch {
+			case trimmed == "import (":
+				inImports, found = true, true
+				res = append(res, line)
+				res = append(res, r.imports...)
+				res = append(res, "")
+				continue
+			case inImports && trimmed == ")":
+				inImports = false
+			case inImports && kept[trimmed]:
+				continue // imported by the region already
+			}
+			res = append(res, line)
+		}
+		if !found {
+			res = nil
+			for _, line := range lines {
+				res = append(res, line)
+				if strings.HasPrefix(line, "package ") {
+					res = append(res, "", "import (")
+					res = append(res, r.imports...)
+					res = append(res, ")")
+				}
+			}
+		}
+		lines = res
+	}
+	for _, region := range r.decls {
+		lines = append(lines, "", region)
+	}
+	res, err := format.Source([]byte(strings.Join(lines, "\n") + "\n"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format source with custom regions: %w", err)
+	}
+	return res, nil
+}
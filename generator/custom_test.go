@@ -0,0 +1,100 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCustomRegions(t *testing.T) {
+	src := `package test
+
+import (
+	// enum:custom-begin
+	"unicode"
+	// enum:custom-end
+	"fmt"
+)
+
+// enum:custom-begin helpers
+func a() {}
+// enum:custom-end
+
+func b() {}
+
+	// enum:custom-begin
+	func c() {}
+	// enum:custom-end
+`
+	regions, err := findCustomRegions([]byte(src))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"\t// enum:custom-begin\n\t\"unicode\"\n\t// enum:custom-end"}, regions.imports)
+	assert.Equal(t, []string{"// enum:custom-begin helpers\nfunc a() {}\n// enum:custom-end",
+		"\t// enum:custom-begin\n\tfunc c() {}\n\t// enum:custom-end"}, regions.decls)
+
+	for src, want := range map[string]string{
+		"// enum:custom-begin\nfunc a() {}\n":                            `custom region at line 1 is not closed with "// enum:custom-end"`,
+		"func a() {}\n// enum:custom-end\n":                              "custom region end at line 2 without a start",
+		"// enum:custom-begin\n// enum:custom-begin\n// enum:custom-end": "custom region at line 2 starts inside the region of line 1",
+	} {
+		_, err := findCustomRegions([]byte(src))
+		assert.EqualError(t, err, want)
+	}
+}
+
+func TestGenerateCustomRegions(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+`
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(src), 0o600))
+	newGen := func(t *testing.T) *Generator {
+		gen, err := New("status", dir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(dir))
+		return gen
+	}
+	require.NoError(t, newGen(t).Generate())
+
+	// add hand-written regions to the generated file, one importing a package the generated code imports too
+	path := filepath.Join(dir, "status_enum.go")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	custom := strings.Replace(string(content), "import (\n", "import (\n\t// enum:custom-begin\n\t\"unicode\"\n\t\"fmt\"\n\t// enum:custom-end\n", 1)
+	custom = strings.Replace(custom, "\t\"fmt\"\n\t\"strings\"\n", "\t\"strings\"\n", 1)
+	custom += "\n// enum:custom-begin\n// IsUpper reports whether the name starts with an upper case letter\n" +
+		"func (e Status) IsUpper() bool { return unicode.IsUpper(rune(fmt.Sprint(e)[0])) }\n// enum:custom-end\n"
+	require.NoError(t, os.WriteFile(path, []byte(custom), 0o600))
+
+	gen := newGen(t)
+	gen.SetGenerateSQL(true)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "import (\n\t// enum:custom-begin\n\t\"fmt\"\n\t\"unicode\"\n\t// enum:custom-end\n\n\t\"database/sql/driver\"\n")
+	assert.Equal(t, 1, strings.Count(string(content), "\t\"fmt\"\n"), "imports of the region are not repeated")
+	assert.True(t, strings.HasSuffix(string(content), "// enum:custom-begin\n// IsUpper reports whether the name starts with an upper case letter\n"+
+		"func (e Status) IsUpper() bool { return unicode.IsUpper(rune(fmt.Sprint(e)[0])) }\n\n// enum:custom-end\n"))
+	assert.Contains(t, string(content), "func (e Status) Value() (driver.Value, error)")
+
+	gen = newGen(t)
+	gen.SetGenerateSQL(true)
+	diff, err := gen.Check()
+	require.NoError(t, err)
+	assert.Empty(t, diff, "regeneration with regions is stable")
+
+	t.Run("unterminated", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, append(content, "// enum:custom-begin\n"...), 0o600))
+		assert.ErrorContains(t, newGen(t).Generate(), "is not closed")
+	})
+}
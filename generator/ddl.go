@@ -0,0 +1,51 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetDDL sets the SQL dialect to write the column definition of the enum for to <type>_enum.ddl.sql,
+// "postgres", "mysql" or "sqlite", nothing is written if empty
+func (g *Generator) SetDDL(dialect string) { g.ddl = dialect }
+
+// getDDLFileName returns the DDL file name for the generated file, e.g. "job_status_enum.ddl.sql"
+func getDDLFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".ddl.sql"
+}
+
+// renderDDL returns the column definition of the enum in the dialect, constraining the column to the values
+// as they are stored by Value: an ENUM column for MySQL, a text column with a CHECK constraint otherwise.
+// The column is named as the type in snake case, e.g. job_status.
+func renderDDL(data TemplateData, dialect string) ([]byte, error) {
+	labels := make([]string, 0, len(data.Values))
+	for _, v := range data.Values {
+		label := v.Name
+		if data.LowerCase {
+			label = strings.ToLower(label)
+		}
+		labels = append(labels, pgQuote(label)) // standard SQL string literals, MySQL accepts them as well
+	}
+	list := strings.Join(labels, ", ")
+
+	var column string
+	switch dialect {
+	case "postgres":
+		name := `"` + data.SQLTypeName + `"`
+		column = fmt.Sprintf("%s TEXT CONSTRAINT %s CHECK (%s IN (%s))", name, data.SQLTypeName+"_check", name, list)
+	case "sqlite":
+		name := `"` + data.SQLTypeName + `"`
+		column = fmt.Sprintf("%s TEXT CHECK (%s IN (%s))", name, name, list)
+	case "mysql":
+		column = fmt.Sprintf("`%s` ENUM(%s)", data.SQLTypeName, list)
+	default:
+		return nil, fmt.Errorf("invalid ddl dialect %q, expected postgres, mysql or sqlite", dialect)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sqlHeader + "\n\n")
+	fmt.Fprintf(&sb, "-- %s column of %s values for %s, e.g. in CREATE TABLE or ALTER TABLE ADD COLUMN\n",
+		data.SQLTypeName, data.Type, dialect)
+	sb.WriteString(column + "\n")
+	return []byte(sb.String()), nil
+}
@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDDL(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive
+	jobStatusOnHold // enum:name=on-hold
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	tbl := []struct {
+		dialect, column string
+	}{
+		{"postgres", `"job_status" TEXT CONSTRAINT job_status_check CHECK ("job_status" IN ('unknown', 'active', 'on-hold'))`},
+		{"sqlite", `"job_status" TEXT CHECK ("job_status" IN ('unknown', 'active', 'on-hold'))`},
+		{"mysql", "`job_status` ENUM('unknown', 'active', 'on-hold')"},
+	}
+	for _, tt := range tbl {
+		t.Run(tt.dialect, func(t *testing.T) {
+			outDir := t.TempDir()
+			gen, err := New("jobStatus", outDir)
+			require.NoError(t, err)
+			require.NoError(t, gen.Parse(srcDir))
+			gen.SetLowerCase(true)
+			require.NoError(t, gen.SetOption("ddl", tt.dialect))
+			require.NoError(t, gen.Generate())
+
+			ddl, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.ddl.sql"))
+			require.NoError(t, err)
+			assert.Equal(t, sqlHeader+"\n\n-- job_status column of jobStatus values for "+tt.dialect+
+				", e.g. in CREATE TABLE or ALTER TABLE ADD COLUMN\n"+tt.column+"\n", string(ddl))
+
+			gen.SetDDL("")
+			require.NoError(t, gen.Generate())
+			assert.NoFileExists(t, filepath.Join(outDir, "job_status_enum.ddl.sql"), "stale file is removed")
+		})
+	}
+
+	t.Run("sqlite constraint", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("jobStatus", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetLowerCase(true)
+		gen.SetDDL("sqlite")
+		require.NoError(t, gen.Generate())
+		ddl, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.ddl.sql"))
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(ddl)), "\n")
+
+		db, err := sql.Open("sqlite", ":memory:")
+		require.NoError(t, err)
+		defer db.Close()
+		_, err = db.Exec("CREATE TABLE jobs (id INTEGER PRIMARY KEY, " + lines[len(lines)-1] + ")")
+		require.NoError(t, err)
+		_, err = db.Exec("INSERT INTO jobs (job_status) VALUES ('on-hold')")
+		require.NoError(t, err)
+		_, err = db.Exec("INSERT INTO jobs (job_status) VALUES ('paused')")
+		require.Error(t, err)
+	})
+
+	t.Run("invalid dialect", func(t *testing.T) {
+		gen, err := New("jobStatus", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetDDL("oracle")
+		require.EqualError(t, gen.Generate(), `invalid ddl dialect "oracle", expected postgres, mysql or sqlite`)
+	})
+}
@@ -0,0 +1,197 @@
+package generator
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// maxListedGaps limits how many gap ranges are printed before the report switches to a summary
+const maxListedGaps = 10
+
+// intRange describes the inclusive range of values representable by an integer type
+type intRange struct {
+	name string
+	min  int64
+	max  uint64
+}
+
+// integerRanges lists integer types from the smallest to the largest, used to find the smallest
+// type able to hold all enum values. int and uint are assumed to be 64-bit.
+var integerRanges = []intRange{
+	{name: "int8", min: math.MinInt8, max: math.MaxInt8},
+	{name: "uint8", min: 0, max: math.MaxUint8},
+	{name: "int16", min: math.MinInt16, max: math.MaxInt16},
+	{name: "uint16", min: 0, max: math.MaxUint16},
+	{name: "int32", min: math.MinInt32, max: math.MaxInt32},
+	{name: "uint32", min: 0, max: math.MaxUint32},
+	{name: "int64", min: math.MinInt64, max: math.MaxInt64},
+	{name: "uint64", min: 0, max: math.MaxUint64},
+}
+
+// typeAliases maps builtin alias and platform-sized integer types to their fixed-size equivalent
+var typeAliases = map[string]string{"byte": "uint8", "rune": "int32", "int": "int64", "uint": "uint64", "uintptr": "uint64"}
+
+// Describe writes a human-readable report about the parsed enum values: min/max value, gaps in the
+// numeric sequence, duplicated values and how much of the underlying type range is used.
+// It helps to pick database column types and to spot accidental renumbering. Parse must be called first.
+func (g *Generator) Describe(w io.Writer) error {
+	if g.stringBacked() {
+		return fmt.Errorf("describe is not supported for string-backed type %s", g.Type)
+	}
+	values := g.buildValues()
+	if len(values) == 0 {
+		return fmt.Errorf("%w for type %s", ErrNoConstants, g.Type)
+	}
+
+	underlying := g.underlyingType
+	if underlying == "" {
+		underlying = "int"
+	}
+
+	// collect distinct values with their names, keeping declaration order of names
+	byValue := make(map[int][]string)
+	for _, v := range values {
+		byValue[v.Index] = append(byValue[v.Index], v.PrivateName)
+	}
+	distinct := make([]int, 0, len(byValue))
+	for v := range byValue {
+		distinct = append(distinct, v)
+	}
+	sort.Ints(distinct)
+	minVal, maxVal := distinct[0], distinct[len(distinct)-1]
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "type %s (%s), %d values, %d distinct\n", g.Type, underlying, len(values), len(distinct))
+	fmt.Fprintf(&sb, "  min:         %d (%s)\n", minVal, strings.Join(byValue[minVal], ", "))
+	fmt.Fprintf(&sb, "  max:         %d (%s)\n", maxVal, strings.Join(byValue[maxVal], ", "))
+
+	// gaps in the numeric sequence between min and max
+	gaps := findGaps(distinct)
+	switch {
+	case len(gaps) == 0:
+		fmt.Fprintf(&sb, "  gaps:        none (contiguous)\n")
+	case len(gaps) > maxListedGaps:
+		fmt.Fprintf(&sb, "  gaps:        %d ranges, %d missing values\n", len(gaps), countMissing(gaps))
+	default:
+		fmt.Fprintf(&sb, "  gaps:        %s (%d missing values)\n", formatGaps(gaps), countMissing(gaps))
+	}
+
+	// duplicated values, in numeric order
+	var dups []string
+	for _, v := range distinct {
+		if names := byValue[v]; len(names) > 1 {
+			dups = append(dups, fmt.Sprintf("%d: %s", v, strings.Join(names, ", ")))
+		}
+	}
+	if len(dups) == 0 {
+		fmt.Fprintf(&sb, "  duplicates:  none\n")
+	} else {
+		fmt.Fprintf(&sb, "  duplicates:  %s\n", strings.Join(dups, "; "))
+	}
+
+	// utilization of the underlying type and the smallest type fitting all values
+	if r, ok := lookupIntRange(underlying); ok {
+		fmt.Fprintf(&sb, "  utilization: %d of %s possible %s values (%s)\n",
+			len(distinct), formatCapacity(r), underlying, formatPercent(len(distinct), r))
+	}
+	if fits := smallestFittingTypes(int64(minVal), int64(maxVal)); len(fits) > 0 {
+		fmt.Fprintf(&sb, "  fits in:     %s\n", strings.Join(fits, ", "))
+	}
+
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return fmt.Errorf("failed to write description: %w", err)
+	}
+	return nil
+}
+
+// gap is an inclusive range of missing values
+type gap struct{ from, to int }
+
+// findGaps returns missing ranges between consecutive sorted distinct values
+func findGaps(sorted []int) []gap {
+	var res []gap
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i]-sorted[i-1] > 1 {
+			res = append(res, gap{from: sorted[i-1] + 1, to: sorted[i] - 1})
+		}
+	}
+	return res
+}
+
+// countMissing returns the total number of values covered by gaps
+func countMissing(gaps []gap) int {
+	total := 0
+	for _, gp := range gaps {
+		total += gp.to - gp.from + 1
+	}
+	return total
+}
+
+// formatGaps renders gaps as "4, 6..9"
+func formatGaps(gaps []gap) string {
+	parts := make([]string, 0, len(gaps))
+	for _, gp := range gaps {
+		if gp.from == gp.to {
+			parts = append(parts, fmt.Sprintf("%d", gp.from))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d..%d", gp.from, gp.to))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// lookupIntRange returns the value range of an integer type name, resolving aliases
+func lookupIntRange(typeName string) (intRange, bool) {
+	if alias, ok := typeAliases[typeName]; ok {
+		typeName = alias
+	}
+	for _, r := range integerRanges {
+		if r.name == typeName {
+			return r, true
+		}
+	}
+	return intRange{}, false
+}
+
+// formatCapacity returns the number of values representable by the range as a string,
+// the 64-bit types overflow uint64 and are rendered as powers of two
+func formatCapacity(r intRange) string {
+	if r.name == "int64" || r.name == "uint64" {
+		return "2^64"
+	}
+	return fmt.Sprintf("%d", uint64(int64(r.max)-r.min)+1)
+}
+
+// formatPercent returns the share of used values in the type range
+func formatPercent(used int, r intRange) string {
+	capacity := float64(r.max) - float64(r.min) + 1
+	pct := float64(used) / capacity * 100
+	if pct < 0.01 {
+		return "<0.01%"
+	}
+	return fmt.Sprintf("%.2f%%", pct)
+}
+
+// smallestFittingTypes returns the smallest signed and unsigned types able to hold [minVal, maxVal]
+func smallestFittingTypes(minVal, maxVal int64) []string {
+	var res []string
+	var signedFound, unsignedFound bool
+	for _, r := range integerRanges {
+		if minVal < r.min || (maxVal > 0 && uint64(maxVal) > r.max) {
+			continue
+		}
+		unsigned := r.min == 0
+		if unsigned && !unsignedFound {
+			res = append(res, r.name)
+			unsignedFound = true
+		}
+		if !unsigned && !signedFound {
+			res = append(res, r.name)
+			signedFound = true
+		}
+	}
+	return res
+}
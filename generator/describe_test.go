@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Run("contiguous", func(t *testing.T) {
+		gen, err := New("status", "")
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+
+		var buf bytes.Buffer
+		require.NoError(t, gen.Describe(&buf))
+		out := buf.String()
+		assert.Contains(t, out, "type status (uint8), 4 values, 4 distinct")
+		assert.Contains(t, out, "min:         0 (statusUnknown)")
+		assert.Contains(t, out, "max:         3 (statusBlocked)")
+		assert.Contains(t, out, "gaps:        none (contiguous)")
+		assert.Contains(t, out, "duplicates:  none")
+		assert.Contains(t, out, "utilization: 4 of 256 possible uint8 values (1.56%)")
+		assert.Contains(t, out, "fits in:     int8, uint8")
+	})
+
+	t.Run("gaps and duplicates", func(t *testing.T) {
+		gen, err := New("repeatValues", "")
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+
+		var buf bytes.Buffer
+		require.NoError(t, gen.Describe(&buf))
+		out := buf.String()
+		assert.Contains(t, out, "4 values, 2 distinct")
+		assert.Contains(t, out, "gaps:        11..19 (9 missing values)")
+		assert.Contains(t, out, "duplicates:  10: repeatValuesFirst, repeatValuesSecond; 20: repeatValuesThird, repeatValuesFourth")
+	})
+
+	t.Run("negative and wide values", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		src := `package test
+type priority int32
+const (
+	priorityNone     priority = -1
+	priorityLow      priority = 0
+	priorityMedium   priority = 100
+	priorityCritical priority = 70000
+)
+`
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "priority.go"), []byte(src), 0o600))
+
+		gen, err := New("priority", "")
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(tmpDir))
+
+		var buf bytes.Buffer
+		require.NoError(t, gen.Describe(&buf))
+		out := buf.String()
+		assert.Contains(t, out, "min:         -1 (priorityNone)")
+		assert.Contains(t, out, "gaps:        1..99, 101..69999 (69998 missing values)")
+		assert.Contains(t, out, "utilization: 4 of 4294967296 possible int32 values (<0.01%)")
+		assert.Contains(t, out, "fits in:     int32")
+	})
+
+	t.Run("many gaps summarized", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		src := `package test
+type sparse int
+const (
+	sparseA sparse = iota * 10
+	sparseB
+	sparseC
+	sparseD
+	sparseE
+	sparseF
+	sparseG
+	sparseH
+	sparseI
+	sparseJ
+	sparseK
+	sparseL
+)
+`
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "sparse.go"), []byte(src), 0o600))
+
+		gen, err := New("sparse", "")
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(tmpDir))
+
+		var buf bytes.Buffer
+		require.NoError(t, gen.Describe(&buf))
+		out := buf.String()
+		assert.Contains(t, out, "type sparse (int)")
+		assert.Contains(t, out, "gaps:        11 ranges, 99 missing values")
+		assert.Contains(t, out, "possible int values")
+	})
+
+	t.Run("not parsed", func(t *testing.T) {
+		gen, err := New("status", "")
+		require.NoError(t, err)
+		require.Error(t, gen.Describe(&bytes.Buffer{}))
+	})
+}
+
+func TestSmallestFittingTypes(t *testing.T) {
+	tests := []struct {
+		min, max int64
+		want     []string
+	}{
+		{0, 3, []string{"int8", "uint8"}},
+		{0, 200, []string{"uint8", "int16"}},
+		{-1, 200, []string{"int16"}},
+		{0, 70000, []string{"int32", "uint32"}},
+		{-5, 5, []string{"int8"}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, smallestFittingTypes(tt.min, tt.max), "range %d..%d", tt.min, tt.max)
+	}
+}
@@ -0,0 +1,49 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidTypeName is returned by New if the type name is empty, exported or not a Go identifier
+	ErrInvalidTypeName = errors.New("invalid type name")
+
+	// ErrNoConstants is returned by Parse if no constants of the type are found
+	ErrNoConstants = errors.New("no const values found")
+
+	// ErrTypeNotFound is returned by Parse, along with ErrNoConstants, if the type is not declared in the
+	// parsed directory, e.g. because of a typo in the name or a file excluded by build constraints
+	ErrTypeNotFound = errors.New("type not found")
+)
+
+// DuplicateValueError is returned by Generate if several constants have the same value, but the value must
+// identify one of them, e.g. for GetStatusByID generated with SetGenerateGetter
+type DuplicateValueError struct {
+	Type   string   // type name as declared, e.g. "status"
+	Value  string   // the value as a Go literal, e.g. "1" or `"active"`
+	Consts []string // names of the constants with the value, sorted
+}
+
+// Error returns the description of the duplicate value
+func (e *DuplicateValueError) Error() string {
+	return fmt.Sprintf("multiple names for value %s: %s", e.Value, strings.Join(e.Consts, ", "))
+}
+
+// DuplicateNameError is returned by Generate if two constants have the same name or alias, compared
+// case-insensitively as parsing ignores case
+type DuplicateNameError struct {
+	Type   string    // type name as declared, e.g. "status"
+	Kind   string    // "name" for names of the values, "alias" for aliases set with enum:alias=
+	Name   string    // the duplicate name or alias, as written for the second constant
+	Consts [2]string // names of the constants, in declaration order for names
+}
+
+// Error returns the description of the duplicate name
+func (e *DuplicateNameError) Error() string {
+	if e.Kind == "alias" {
+		return fmt.Sprintf("duplicate alias %q: used by both %s and %s", e.Name, e.Consts[0], e.Consts[1])
+	}
+	return fmt.Sprintf("duplicate name %q: used by both %s and %s, names are case-insensitive", e.Name, e.Consts[0], e.Consts[1])
+}
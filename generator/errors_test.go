@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrors(t *testing.T) {
+	for _, name := range []string{"", "Status", "job-status"} {
+		_, err := New(name, "")
+		require.ErrorIs(t, err, ErrInvalidTypeName, name)
+	}
+
+	parse := func(t *testing.T, typeName, src string) (*Generator, error) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(src), 0o600))
+		gen, err := New(typeName, dir)
+		require.NoError(t, err)
+		return gen, gen.Parse(dir)
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := parse(t, "statuss", "package test\n\ntype status int\n\nconst statusActive status = 1\n")
+		require.ErrorIs(t, err, ErrNoConstants)
+		require.ErrorIs(t, err, ErrTypeNotFound)
+		assert.EqualError(t, err, "no const values found for type statuss: type not found")
+
+		_, err = parse(t, "status", "package test\n\ntype status int\n")
+		require.ErrorIs(t, err, ErrNoConstants)
+		assert.NotErrorIs(t, err, ErrTypeNotFound)
+	})
+
+	t.Run("duplicate value", func(t *testing.T) {
+		gen, err := parse(t, "status", "package test\n\ntype status int\n\nconst (\n\tstatusB status = 1\n\tstatusA status = 1\n\tstatusC status = 2\n)\n")
+		require.NoError(t, err)
+		gen.SetGenerateGetter(true)
+		var dupErr *DuplicateValueError
+		require.ErrorAs(t, gen.Generate(), &dupErr)
+		assert.Equal(t, DuplicateValueError{Type: "status", Value: "1", Consts: []string{"statusA", "statusB"}}, *dupErr)
+		assert.EqualError(t, dupErr, "multiple names for value 1: statusA, statusB")
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		gen, err := parse(t, "status", "package test\n\ntype status int\n\nconst (\n\tstatusActive status = iota\n"+
+			"\tstatusOn // enum:alias=up\n\tstatusUp2 // enum:alias=UP\n)\n")
+		require.NoError(t, err)
+		var dupErr *DuplicateNameError
+		require.ErrorAs(t, gen.Generate(), &dupErr)
+		assert.Equal(t, DuplicateNameError{Type: "status", Kind: "alias", Name: "UP", Consts: [2]string{"statusOn", "statusUp2"}}, *dupErr)
+
+		gen, err = parse(t, "status", "package test\n\ntype status int\n\nconst (\n\tstatusActive status = iota\n\tstatusActivE\n)\n")
+		require.NoError(t, err)
+		require.ErrorAs(t, gen.Generate(), &dupErr)
+		assert.Equal(t, DuplicateNameError{Type: "status", Kind: "name", Name: "ActivE", Consts: [2]string{"statusActive", "statusActivE"}}, *dupErr)
+		assert.EqualError(t, dupErr, `duplicate name "ActivE": used by both statusActive and statusActivE, names are case-insensitive`)
+	})
+}
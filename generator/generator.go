@@ -0,0 +1,2499 @@
+// Package generator provides a code generator for enum types. It reads Go source files and extracts enum values
+// to generate a new type with text marshaling support by default. Optional flags add SQL, BSON (MongoDB), YAML and TOML support.
+//
+// The enum command is a thin wrapper over this package, so other tools, like scaffolders and build plugins,
+// can embed the generation without running the binary:
+//
+//	gen, err := generator.New("status", "")
+//	if err != nil {
+//		return err
+//	}
+//	gen.SetGenerateSQL(true)
+//	if err := gen.Parse("."); err != nil {
+//		return err
+//	}
+//	return gen.Generate()
+//
+// Options are set before Parse, which applies the "enum:" directive of the type on top of them. The generated
+// code can be written elsewhere with GenerateTo or checked against the files on disk with Check.
+package generator
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/build/constraint"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/fs"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/tools/go/packages"
+)
+
+var titleCaser = cases.Title(language.English, cases.NoLower)
+
+// Generator holds the data needed for enum code generation
+type Generator struct {
+	Type                string                 // the private type name (e.g., "status")
+	Path                string                 // output directory path
+	srcDir              string                 // source directory passed to Parse
+	declared            map[string]bool        // package-level names declared in source files, generated ones excluded
+	declaredMethods     map[string]string      // methods declared in source files as "Type.Method", with their file and line
+	generated           map[string]bool        // package-level names declared in previously generated files
+	values              map[string]*constValue // const values found with metadata
+	pkgName             string                 // package name from source file
+	lowerCase           bool                   // use lower case for marshal/unmarshal
+	stringCase          string                 // case of names from multi-word constants: snake, kebab, screaming, camel or as-is
+	trimPrefix          string                 // prefix removed after the type name from names and public constants
+	generateGetter      bool                   // generate getter methods for enum values
+	underlyingType      string                 // underlying type (e.g., "uint8", "int", etc.)
+	generateSQL         bool                   // generate SQL interfaces and imports
+	generateBSON        bool                   // generate BSON interfaces and imports
+	generateYAML        bool                   // generate YAML interfaces and imports
+	generateTOML        bool                   // generate TOML interfaces and imports
+	generatePgx         bool                   // generate pgx v5 text codec interfaces and type registration
+	generatePgEnum      bool                   // write Postgres enum type migration file
+	ddl                 string                 // SQL dialect of the column definition file, "postgres", "mysql" or "sqlite"
+	splitFiles          bool                   // write optional integrations to separate per-feature files
+	splitTags           map[string]string      // build constraints of per-feature files by feature, e.g. "bson": "mongo"
+	generateFlags       bool                   // generate bitmask flags type for power-of-two values
+	generateSet         bool                   // generate bitset type holding a set of values
+	wrap                bool                   // Next and Prev wrap around the ends of the values
+	generateTestHelpers bool                   // generate test helpers into a _test.go file
+	generateRapid       bool                   // generate pgregory.net/rapid generator with the test helpers
+	generateTests       bool                   // generate regression tests of the generated code
+	generateExamples    bool                   // generate godoc examples of the generated code
+	crossPackage        bool                   // generate into another package, the guard goes to a bridge file next to the constants
+	unexported          bool                   // generate unexported names, for enums internal to the package
+	names               map[string]string      // names of generated identifiers replacing the default ones
+	namePrefix          string                 // prefix of generated package-level names, except for the type and values
+	nameSuffix          string                 // suffix of generated package-level names, except for the type and values
+	initialisms         []string               // domain initialisms splitting and upper-cased in names, longer first
+	generateJSONMap     bool                   // generate JSON marshaling helper for enum-keyed maps
+	generateMap         bool                   // generate array-backed map type keyed by the enum
+	generateChecked     bool                   // generate checked constructors from raw integers
+	generateCompletion  bool                   // generate flag.Value methods and shell completion function
+	generateEnv         bool                   // generate environment variable helpers
+	generateSwag        bool                   // generate swaggo enums constant
+	generateFormatter   bool                   // generate fmt.Formatter printing name=value for %+v
+	packed              bool                   // store names in a single string instead of each value
+	errorValues         bool                   // list valid names in parse errors
+	fallback            string                 // value returned by Parse for unknown names instead of an error
+	nullValue           string                 // value SQL NULL is scanned to instead of the zero value
+	nullStrict          bool                   // scanning SQL NULL is an error even if there is a zero value
+	generateGraphQL     bool                   // generate gqlgen MarshalGQL and UnmarshalGQL methods
+	generateRedis       bool                   // generate binary marshaling and redigo Argument and Scanner methods
+	generateNull        bool                   // generate the Null<Type> wrapper for nullable columns, implies SQL
+	generateGQLGen      bool                   // write gqlgen.yml models stanza for the type
+	generateEnt         bool                   // generate Values method and SQL interfaces for entgo.io enum fields
+	generateSQLC        bool                   // write sqlc type overrides and generate SQL interfaces
+	hideDeprecated      bool                   // exclude deprecated values from names and completion
+	translations        string                 // translations file with localized names, JSON or YAML
+	generateJSONSchema  bool                   // write JSON schema file and constant
+	generateOpenAPI     bool                   // write OpenAPI component schema file
+	tsOut               string                 // absolute directory to write TypeScript definitions to
+	protoOut            string                 // absolute directory to write the protobuf enum declaration to
+	generateJSONExample bool                   // generate JSON example of each value for contract tests
+	generateInfo        bool                   // generate descriptors of the values for enum catalogs
+	immutableValues     bool                   // generate StatusValues as a function returning a copy
+	generateSeq         bool                   // generate iter.Seq iterators over values, names and entries
+	generateSorted      bool                   // generate iterators by value, by name and in reverse order
+	generateDescriptor  bool                   // generate the introspection descriptor of the enum
+	generateRegistry    bool                   // register the descriptor in the registry package at init
+	runtime             bool                   // generate thin code delegating to the shared enumrt runtime package
+	generic             bool                   // use the generic helpers of enumcore for parsing, marshaling and iteration
+	guard               string                 // unused constants guard: "func" (default), "var" or "none"
+	marshalNumber       bool                   // marshal to text, JSON, BSON, YAML and TOML as numeric values instead of names
+	parseMode           string                 // parse lookup: "map" (default), "lazy" or "switch"
+	existingMethods     string                 // generated methods already declared in the package: "fail" (default) or "skip"
+	tolerant            bool                   // accept numeric values in addition to names when unmarshaling
+	version             string                 // version of the running generator, e.g. "v1.3.0"
+	requireVersion      string                 // minimal generator version required to generate the type
+	header              string                 // header template file prepended to generated Go files
+	fileNamePattern     string                 // pattern of the generated file name, "<type>_enum.go" if empty
+	buildTags           []string               // additional build tags to satisfy build constraints of parsed files
+	templatePath        string                 // custom template file or directory, embedded template is used if empty
+	presets             map[string][]string    // named option sets applied with the "preset" option
+	templateExtras      map[string]string      // template hook name to snippet file filling it
+	typesInfo           *types.Info            // type-checked info of the package being parsed, nil outside of Parse
+	enumType            types.Type             // type-checked enum type, nil if not declared in the package
+	typeErr             error                  // first type error of the package being parsed, nil if none
+	fset                *token.FileSet         // file set of the package being parsed, nil outside of Parse
+	typeFound           bool                   // the type declaration was found by Parse
+	verbose             io.Writer              // writer of parsing diagnostics, nil if disabled
+	output              []OutputFile           // files written and removed by the last generation
+	methods             []Method               // predicate methods with value names as given in the option
+}
+
+// constValue holds metadata about a const during parsing
+type constValue struct {
+	value   int       // the numeric value
+	str     string    // the string value, for string-backed enums
+	pos     token.Pos // source position for ordering
+	aliases []string  // aliases from comment annotation
+	comment string    // free-text doc comment (enum: directives excluded)
+	desc    string    // description from enum:desc=, the comment is used if empty
+	since   string    // first API version the value is available in, from enum:since=
+	until   string    // last API version the value is available in, from enum:until=
+	public  string    // exported constant name pinned with enum:public=, derived from the name if empty
+	name    string    // string form pinned with enum:name=, derived from the name if empty
+	order   string    // order of the const block from enum:order= in the block doc comment
+
+	deprecated  bool   // value is deprecated with enum:deprecated
+	deprecation string // deprecation reason from enum:deprecated=reason, optional
+}
+
+// blockOrder returns the order of the const block the value is declared in, 0 if not set or invalid
+func (cv *constValue) blockOrder() int {
+	n, _ := strconv.Atoi(cv.order)
+	return n
+}
+
+// Value represents a single enum value
+type Value struct {
+	PrivateName string   // e.g., "statusActive"
+	PublicName  string   // e.g., "StatusActive"
+	Name        string   // e.g., "Active"
+	Index       int      // enum index value
+	Literal     string   // Go literal of the value, e.g. "1", or "\"admin\"" for string-backed enums
+	Aliases     []string // e.g., ["rw", "read-write"] from // enum:alias=rw,read-write
+	Comment     string   // doc comment for the generated public constant
+	Description string   // human-readable description from enum:desc= or the doc comment
+	Since       string   // first API version the value is available in, e.g. "v2"
+	Until       string   // last API version the value is available in, e.g. "v3"
+	Deprecated  bool     // value is deprecated with enum:deprecated
+	Deprecation string   // deprecation reason, e.g. "use StatusBlocked"
+	GraphQLName string   // GraphQL enum value name, e.g. "IN_PROGRESS", set if GraphQL methods are generated
+}
+
+// ParseCase is a value with its names for the case-insensitive switch of Parse
+type ParseCase struct {
+	PublicName string   // e.g. "StatusActive"
+	Names      []string // name and aliases, e.g. ["Active", "on"]
+}
+
+// PackedNames holds names of all values in a single string, like stringer does, so values don't store strings
+type PackedNames struct {
+	Names     string        // names concatenated in declaration order
+	Index     []int         // offsets of the names in Names, starting with the empty name of the zero value
+	IndexType string        // smallest unsigned type holding the offsets, e.g. "uint16"
+	OrdType   string        // smallest unsigned type holding positions of the values plus one
+	Values    []PackedValue // position and name offsets of each value
+}
+
+// PackedValue is a value of PackedNames
+type PackedValue struct {
+	Ord        int // position in values plus one, zero is the zero value
+	Start, End int // offsets of the name in PackedNames.Names
+}
+
+// Method is a predicate method reporting whether the value is one of the listed values
+type Method struct {
+	Name   string   // method name, e.g. "IsTerminal"
+	Values []string // values the method returns true for, public names in TemplateData, e.g. "StatusBlocked"
+}
+
+// TemplateData is the data passed to the enum template, custom templates set with SetTemplate receive it as well
+type TemplateData struct {
+	Type                string        // the private type name, e.g. "status"
+	GoType              string        // the exported type name, e.g. "Status", or "SKUStatus" for skuStatus with initialisms
+	Values              []Value       // enum values in declaration order
+	Package             string        // package name of the generated file
+	LowerCase           bool          // use lower case names for marshal/unmarshal
+	GenerateGetter      bool          // generate GetByID function
+	UnderlyingType      string        // underlying type, e.g. "uint8" or "string"
+	GenerateSQL         bool          // generate SQL Valuer and Scanner
+	GenerateBSON        bool          // generate BSON marshaling
+	GenerateYAML        bool          // generate YAML marshaling
+	GenerateTOML        bool          // generate TOML marshaling
+	GeneratePgx         bool          // generate pgx v5 text codec interfaces and type registration
+	SQLTypeName         string        // snake case name of the type in databases, e.g. "job_status"
+	SplitFiles          bool          // optional integrations are written to separate files
+	GenerateFlags       bool          // generate bitmask flags type
+	GenerateSet         bool          // generate bitset type of values
+	SetWords            int           // number of uint64 words in the bitset, one bit per value
+	Wrap                bool          // Next and Prev wrap around the ends of the values
+	GenerateRapid       bool          // generate pgregory.net/rapid generator in the test helpers file
+	GenerateJSONMap     bool          // generate JSON helper for enum-keyed maps
+	GenerateMap         bool          // generate array-backed map type
+	GenerateChecked     bool          // generate checked constructors from integers
+	GenerateCompletion  bool          // generate flag.Value methods and shell completion function
+	GenerateEnv         bool          // generate <Type>FromEnv and Must<Type>FromEnv
+	SwagEnums           string        // comma-separated values as marshaled for swaggo annotations, empty if not generated
+	GenerateFormatter   bool          // generate fmt.Formatter
+	Packed              *PackedNames  // names concatenated in a single string, nil if values store their names
+	NameCall            string        // "()" appended to the name field to call the name method of packed values
+	ErrorValues         string        // valid names and aliases listed in parse errors, empty if not listed
+	Fallback            string        // public name of the value returned for unknown input, e.g. "StatusUnknown"
+	NullValue           string        // public name of the value SQL NULL is scanned to, empty for the zero value
+	NullStrict          bool          // scanning SQL NULL returns an error
+	GenerateGraphQL     bool          // generate gqlgen MarshalGQL and UnmarshalGQL
+	GenerateRedis       bool          // generate MarshalBinary, UnmarshalBinary, RedisArg and RedisScan
+	GenerateNull        bool          // generate the Null<Type> wrapper with Valuer, Scanner and JSON methods
+	GenerateEnt         bool          // generate Values method for entgo.io enum fields
+	GenerateJSONExample bool          // generate JSON example of each value
+	GenerateInfo        bool          // generate <Type>Info descriptors of the values and MarshalAll<Type>JSON
+	ImmutableValues     bool          // <Type>Values is a function returning a copy of the private values slice
+	GenerateDescriptor  bool          // generate <Type>Descriptor returning the introspection descriptor of the enum
+	RegistryName        string        // name the descriptor is registered with, e.g. "example.com/app.Status", empty if not
+	Generic             bool          // parsing, marshaling and iteration use the generic helpers of enumcore
+	GenerateSeq         bool          // generate iter.Seq iterators <Type>All, <Type>NamesSeq and <Type>Entries
+	ByValue             []string      // public names sorted by value for <Type>IterByValue, nil if not generated
+	ByName              []string      // public names sorted by name for <Type>IterByName, nil if not generated
+	ValuesVar           string        // name of the values slice in the generated code, e.g. "StatusValues" or "_statusValues"
+	Guard               string        // form of the unused constants guard, "func", "var" or "none"
+	Unexported          bool          // names are unexported by renaming the generated code
+	MarshalNumber       bool          // marshal values as numbers instead of names
+	ParseMode           string        // parse lookup, "map", "lazy" or "switch"
+	ParseMaxLen         int           // length of the longest name or alias in lower case, in bytes, for the switch of Parse
+	ParseCases          []ParseCase   // names and aliases of all values for the case-insensitive switch of non-ASCII input
+	Tolerant            bool          // accept numeric values in addition to names when unmarshaling
+	UniqueValues        bool          // all values are distinct
+	StringBacked        bool          // the underlying type is string
+	ZeroLiteral         string        // Go literal of the zero value, "0" or `""`
+	HasVersions         bool          // some values have since/until annotations
+	HasAliases          bool          // some values have parsing aliases
+	HasDescriptions     bool          // some values have descriptions
+	MinValue            string        // public name of the value with the smallest number, empty for string-backed enums
+	MaxValue            string        // public name of the value with the largest number, empty for string-backed enums
+	HasDeprecated       bool          // some values are deprecated
+	HideDeprecated      bool          // exclude deprecated values from names and completion
+	Translations        []Translation // localized names per locale, sorted by locale
+	JSONSchema          string        // JSON schema of the type, empty if not generated
+	Methods             []Method      // predicate methods, e.g. IsTerminal
+}
+
+// New creates a new Generator instance
+func New(typeName, path string) (*Generator, error) {
+	if typeName == "" {
+		return nil, fmt.Errorf("%w: type name is required", ErrInvalidTypeName)
+	}
+	if !unicode.IsLower(rune(typeName[0])) {
+		return nil, fmt.Errorf("%w %q: first letter must be lowercase (private)", ErrInvalidTypeName, typeName)
+	}
+	if !isValidGoIdentifier(typeName) || token.IsKeyword(typeName) {
+		return nil, fmt.Errorf("%w %q, must be a Go identifier and not a keyword", ErrInvalidTypeName, typeName)
+	}
+
+	return &Generator{
+		Type:   typeName,
+		Path:   path,
+		values: make(map[string]*constValue),
+	}, nil
+}
+
+// SetLowerCase sets the lower case flag for marshal/unmarshal values
+func (g *Generator) SetLowerCase(lower bool) {
+	g.lowerCase = lower
+}
+
+// SetGenerateGetter sets the flag to generate getter methods for enum values
+func (g *Generator) SetGenerateGetter(generate bool) {
+	g.generateGetter = generate
+}
+
+// SetGenerateSQL enables or disables generation of SQL interfaces
+func (g *Generator) SetGenerateSQL(v bool) { g.generateSQL = v }
+
+// SetGenerateEnt enables or disables generation of the Values method expected by entgo.io for enum fields
+// with a Go type, along with SQL interfaces ent stores and reads the values with
+func (g *Generator) SetGenerateEnt(v bool) { g.generateEnt = v }
+
+// SetGenerateBSON enables or disables generation of BSON interfaces
+func (g *Generator) SetGenerateBSON(v bool) { g.generateBSON = v }
+
+// SetGenerateYAML enables or disables generation of YAML interfaces
+func (g *Generator) SetGenerateYAML(v bool) { g.generateYAML = v }
+
+// SetGenerateTOML enables or disables generation of TOML interfaces
+func (g *Generator) SetGenerateTOML(v bool) { g.generateTOML = v }
+
+// SetGeneratePgx enables or disables generation of pgx v5 TextValuer and TextScanner interfaces and
+// the RegisterStatusType function registering the Postgres enum type of the same name
+func (g *Generator) SetGeneratePgx(v bool) { g.generatePgx = v }
+
+// SetSplitFiles enables or disables writing optional integrations (SQL, BSON, YAML, TOML, pgx) to separate files,
+// e.g. status_enum_sql.go, next to the main status_enum.go
+func (g *Generator) SetSplitFiles(v bool) { g.splitFiles = v }
+
+// SetSplitTag sets the build constraint written as a "//go:build" line into the separate file of the feature,
+// e.g. SetSplitTag("bson", "mongo") excludes status_enum_bson.go from builds without the mongo tag.
+// The feature is one of sql, bson, yaml, toml or pgx, an empty constraint removes it.
+func (g *Generator) SetSplitTag(feature, constraint string) {
+	if g.splitTags == nil {
+		g.splitTags = make(map[string]string)
+	}
+	if constraint == "" {
+		delete(g.splitTags, feature)
+		return
+	}
+	g.splitTags[feature] = constraint
+}
+
+// SetGenerateRedis enables or disables generation of MarshalBinary and UnmarshalBinary used by go-redis,
+// and RedisArg and RedisScan of redigo, to pass the enum to Redis commands and scan replies directly
+func (g *Generator) SetGenerateRedis(v bool) { g.generateRedis = v }
+
+// SetGenerateFlags enables or disables generation of the bitmask flags type (e.g. StatusFlags) combining
+// multiple values. All values must be zero or a power of two.
+func (g *Generator) SetGenerateFlags(v bool) { g.generateFlags = v }
+
+// SetGenerateSet enables or disables generation of the set type (e.g. StatusSet), a bitset with a bit per value
+// in declaration order, unlike flags it works for any values
+func (g *Generator) SetGenerateSet(v bool) { g.generateSet = v }
+
+// SetWrap makes Next return the first value after the last one and Prev the last value before the first one
+func (g *Generator) SetWrap(v bool) { g.wrap = v }
+
+// SetGenerateTestHelpers enables or disables generation of test helpers, like RandomStatus, into
+// <type>_enum_helpers_test.go, so they are available to tests of the package without being part of its API
+func (g *Generator) SetGenerateTestHelpers(v bool) { g.generateTestHelpers = v }
+
+// SetGenerateRapid enables or disables generation of the pgregory.net/rapid generator, e.g. RapidStatus,
+// with the test helpers, implies them
+func (g *Generator) SetGenerateRapid(v bool) { g.generateRapid = v }
+
+// SetGenerateTests enables or disables generation of <type>_enum_test.go with regression tests of the generated
+// code: parsing of every name and round trips of text, JSON, and, if generated, SQL, YAML and getter methods
+func (g *Generator) SetGenerateTests(v bool) { g.generateTests = v }
+
+// SetGenerateExamples enables or disables generation of <type>_enum_example_test.go with runnable examples,
+// e.g. ExampleParseStatus, shown in the package documentation
+func (g *Generator) SetGenerateExamples(v bool) { g.generateExamples = v }
+
+// SetGenerateJSONMap enables or disables generation of MarshalStatusMapJSON helper, encoding enum-keyed maps
+// with keys in declaration order
+func (g *Generator) SetGenerateJSONMap(v bool) { g.generateJSONMap = v }
+
+// SetGenerateMap enables or disables generation of StatusMap[V] lookup table type, backed by fixed-size arrays
+// indexed by the value position
+func (g *Generator) SetGenerateMap(v bool) { g.generateMap = v }
+
+// SetGenerateChecked enables or disables generation of checked constructors NewStatusFromInt and
+// MustStatusFromInt, validating raw integers against defined values
+func (g *Generator) SetGenerateChecked(v bool) { g.generateChecked = v }
+
+// SetGuard sets the form of the block preventing unused errors for the original constants: "func" for
+// the default anonymous function, "var" for plain "_ = statusActive" assignments, or "none" to omit it.
+// An empty mode means the default.
+func (g *Generator) SetGuard(mode string) { g.guard = mode }
+
+// SetParseMode sets how Parse looks up values: "map" for a map built at package init, "lazy" for a map
+// built on first use, or "switch" for a switch statement without a map. An empty mode means the default.
+func (g *Generator) SetParseMode(mode string) { g.parseMode = mode }
+
+// SetGenerateCompletion enables or disables generation of Set and Type methods implementing flag.Value
+// and pflag.Value, and of StatusCompletionFunc completing value names in shells, e.g. with cobra
+func (g *Generator) SetGenerateCompletion(v bool) { g.generateCompletion = v }
+
+// SetGenerateSwag enables or disables generation of the StatusSwaggerEnums constant with values as they are
+// marshaled, joined with commas, and ready-to-paste swaggo annotations in its doc comment
+func (g *Generator) SetGenerateSwag(v bool) { g.generateSwag = v }
+
+// SetGenerateEnv enables or disables generation of StatusFromEnv and MustStatusFromEnv reading the value
+// from an environment variable, with a default for unset or empty ones
+func (g *Generator) SetGenerateEnv(v bool) { g.generateEnv = v }
+
+// SetGenerateFormatter enables or disables generation of Format implementing fmt.Formatter, printing
+// name=value for %+v, e.g. in debug dumps of structs
+func (g *Generator) SetGenerateFormatter(v bool) { g.generateFormatter = v }
+
+// SetErrorValues makes parse errors list valid names and aliases, e.g. `invalid status "foo", valid values:
+// unknown, active`, truncated to the first errorValuesLimit ones for big enums
+func (g *Generator) SetErrorValues(v bool) { g.errorValues = v }
+
+// SetFallback sets the value, e.g. "unknown" or "StatusUnknown", returned by Parse and unmarshaling for unknown
+// names and numbers instead of an error, for forward compatibility with values added by newer producers.
+// The raw input is passed to the generated OnUnknown<Type> hook if set. Strict parsing is used if empty.
+func (g *Generator) SetFallback(name string) { g.fallback = name }
+
+// SetNullValue sets the value, e.g. "unknown" or "StatusUnknown", SQL NULL is scanned to by Scan and pgx ScanText,
+// instead of the value with the zero number, or an error if there is none
+func (g *Generator) SetNullValue(name string) { g.nullValue = name }
+
+// SetNullStrict makes scanning SQL NULL return an error even if the enum has a value with the zero number,
+// for columns which must not be NULL
+func (g *Generator) SetNullStrict(v bool) { g.nullStrict = v }
+
+// SetPacked stores names of all values in a single string indexed by offsets, like stringer does, instead of
+// a name field of each value. Values become pointer-free, which cuts binary size and GC work for big enums.
+func (g *Generator) SetPacked(v bool) { g.packed = v }
+
+// SetHideDeprecated excludes values marked with enum:deprecated from the names list and shell completion,
+// they are still parsed and unmarshaled to keep existing data readable
+func (g *Generator) SetHideDeprecated(v bool) { g.hideDeprecated = v }
+
+// SetVersion sets the version of the running generator, checked against the version set with
+// SetRequireVersion. Development builds without a version, e.g. "(devel)", satisfy any requirement.
+func (g *Generator) SetVersion(v string) { g.version = v }
+
+// SetRequireVersion sets the minimal generator version, e.g. "v1.3.0", generation fails if the running
+// generator is older. It keeps a team on the same generator to avoid diffs in the generated code.
+func (g *Generator) SetRequireVersion(v string) { g.requireVersion = v }
+
+// AddMethod adds a predicate method returning true for the listed values, e.g. AddMethod("IsTerminal",
+// "Blocked", "Deleted") generates "func (e Status) IsTerminal() bool". Values can be given as bare (Blocked),
+// private (statusBlocked) or public (StatusBlocked) names.
+func (g *Generator) AddMethod(name string, values ...string) {
+	g.methods = append(g.methods, Method{Name: name, Values: values})
+}
+
+// SetTolerant sets the flag to accept numeric values in addition to names when unmarshaling
+// from text, JSON, BSON and YAML, e.g. during a migration from integer to string encoding
+func (g *Generator) SetTolerant(v bool) { g.tolerant = v }
+
+// SetMarshalNumber sets the flag to marshal values to text, JSON, BSON and YAML as numbers instead of names
+func (g *Generator) SetMarshalNumber(v bool) { g.marshalNumber = v }
+
+// SetGenerateJSONExample sets the flag to generate TypeJSONExample with the JSON encoding of each value
+func (g *Generator) SetGenerateJSONExample(v bool) { g.generateJSONExample = v }
+
+// SetGenerateInfo sets the flag to generate TypeInfo returning descriptors of the values with names, aliases,
+// descriptions and deprecations, and MarshalAllTypeJSON encoding them, for catalogs of the enum in APIs and UIs
+func (g *Generator) SetGenerateInfo(v bool) { g.generateInfo = v }
+
+// SetImmutableValues sets the flag to generate StatusValues as a function returning a copy of the values,
+// kept in a private slice, so callers appending to it or writing its elements don't affect each other
+func (g *Generator) SetImmutableValues(v bool) { g.immutableValues = v }
+
+// SetGenerateSeq sets the flag to generate StatusAll, StatusNamesSeq and StatusEntries returning iter.Seq
+// and iter.Seq2 iterators over the values, the names, and positions with values
+func (g *Generator) SetGenerateSeq(v bool) { g.generateSeq = v }
+
+// SetGenerateDescriptor sets the flag to generate StatusDescriptor returning StatusEnumDescriptor, describing
+// the type and its values with methods of standard types only, for generic introspection of enums
+func (g *Generator) SetGenerateDescriptor(v bool) { g.generateDescriptor = v }
+
+// SetGenerateRegistry sets the flag to register StatusDescriptor in the github.com/go-pkgz/enum/registry package
+// at init, under the import path of the package and the type name. It implies the descriptor.
+func (g *Generator) SetGenerateRegistry(v bool) { g.generateRegistry = v }
+
+// SetBuildTags sets additional build tags used to evaluate build constraints of source files in Parse,
+// e.g. SetBuildTags([]string{"integration"}) includes files with "//go:build integration"
+func (g *Generator) SetBuildTags(tags []string) { g.buildTags = tags }
+
+// SetTemplate sets a custom template file or directory of *.tmpl files used instead of the embedded template.
+// Templates receive TemplateData and are parsed on top of the embedded one, so they can override
+// its named templates (e.g. "sql") while keeping the rest.
+func (g *Generator) SetTemplate(path string) { g.templatePath = path }
+
+// SetTemplateExtras sets snippet files filling the extension hooks of the template, keyed by hook name:
+// "extraImports" for additional import lines and "extraMethods" for code added after the generated methods.
+// Snippets are templates receiving TemplateData, like the main template.
+func (g *Generator) SetTemplateExtras(extras map[string]string) { g.templateExtras = extras }
+
+// Parse reads the source directory and extracts enum information. It type-checks the package and
+// collects the constants of the enum type, for example if type is "status", it will find all constants
+// declared as status, directly or by repeating the previous expression. The values map will contain
+// the const name and its value, for example: {"statusActive": 1, "statusInactive": 2}.
+//
+// Imported packages are loaded with go/packages, so constant values come from go/constant and any constant
+// expression (iota arithmetic, shifts, references to other or imported constants) is evaluated the way
+// the compiler does. Untyped constants and constants of other types (e.g. "statusCount int = 4") are
+// skipped whatever their names are. A constant of the type the type checker can't evaluate fails parsing.
+//
+// Build constraints are honored the same way as by the go command: files with "//go:build" lines or
+// _GOOS/_GOARCH name suffixes not matching the current platform (GOOS and GOARCH environment variables
+// are respected) and build tags set with SetBuildTags are skipped.
+func (g *Generator) Parse(dir string) error {
+	g.srcDir = dir
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, g.logSkipped(buildFilter(dir, g.buildTags)), parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("failed to parse directory: %w", err)
+	}
+	return g.parsePackages(fset, pkgs)
+}
+
+// logSkipped returns the filter logging files it rejects as excluded by build constraints
+func (g *Generator) logSkipped(filter func(fs.FileInfo) bool) func(fs.FileInfo) bool {
+	return func(fi fs.FileInfo) bool {
+		if !filter(fi) {
+			g.logf(token.NoPos, "%s: skipped, excluded by build constraints", fi.Name())
+			return false
+		}
+		return true
+	}
+}
+
+// parsePackages extracts enum information from the parsed packages of the source directory
+func (g *Generator) parsePackages(fset *token.FileSet,
+	pkgs map[string]*ast.Package) error { //nolint:staticcheck // ast.Package is what ParseDir returns
+	// process each package
+	g.fset = fset
+	defer func() { g.fset = nil }()
+	g.generated = make(map[string]bool)
+	for _, pkg := range pkgs {
+		if len(pkgs) > 1 && strings.HasSuffix(pkg.Name, "_test") {
+			continue // external tests of the package, e.g. status_test, import it and can't declare the enum
+		}
+		g.pkgName = pkg.Name
+		if err := g.typeCheck(fset, pkg); err != nil {
+			return err
+		}
+		g.declared = make(map[string]bool)
+		g.declaredMethods = make(map[string]string)
+		for _, name := range sortedKeys(pkg.Files) {
+			file := pkg.Files[name]
+			if writtenByGenerator(file) {
+				g.logf(file.Package, "skipped, generated by enum")
+				for _, name := range declaredNames(file) {
+					g.generated[name] = true
+				}
+				continue // previously generated code declares no constants of the type
+			}
+			g.logf(file.Package, "scanning package %s", pkg.Name)
+			for _, name := range declaredNames(file) {
+				g.declared[name] = true
+			}
+			maps.Copy(g.declaredMethods, methodPositions(fset, file))
+			if err := g.parseFile(file); err != nil {
+				return err
+			}
+		}
+	}
+	g.typesInfo, g.enumType, g.typeErr = nil, nil, nil
+	g.logf(token.NoPos, "%d const value(s) found for type %s", len(g.values), g.Type)
+
+	if len(g.values) == 0 {
+		if !g.typeFound {
+			return fmt.Errorf("%w for type %s: %w", ErrNoConstants, g.Type, ErrTypeNotFound)
+		}
+		return fmt.Errorf("%w for type %s", ErrNoConstants, g.Type)
+	}
+
+	return nil
+}
+
+// parseFile processes a single file for enum declarations
+func (g *Generator) parseFile(file *ast.File) error {
+	// first pass: look for the type declaration to get underlying type and generation options
+	if err := g.extractUnderlyingType(file); err != nil {
+		return err
+	}
+
+	// second pass: extract const values of package-level const blocks
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.CONST {
+			if err := g.parseConstBlock(decl); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// extractUnderlyingType finds the type declaration and extracts its underlying type.
+// Generation options from the "enum:" directive in the type doc comment are applied as well.
+func (g *Generator) extractUnderlyingType(file *ast.File) error {
+	var errs []error
+	ast.Inspect(file, func(n ast.Node) bool {
+		if decl, ok := n.(*ast.GenDecl); ok && decl.Tok == token.TYPE {
+			for _, spec := range decl.Specs {
+				if tspec, ok := spec.(*ast.TypeSpec); ok && tspec.Name.Name == g.Type {
+					// found our type, extract the underlying type
+					g.typeFound = true
+					if ident, ok := tspec.Type.(*ast.Ident); ok {
+						g.underlyingType = ident.Name
+					}
+					g.logf(tspec.Pos(), "type %s %s declared", g.Type, types.ExprString(tspec.Type))
+					for _, opt := range typeDirectiveOptions(decl, tspec) {
+						g.logf(tspec.Pos(), "option %s from the enum: directive", opt)
+						name, value, _ := strings.Cut(opt, "=")
+						if err := g.SetOption(name, value); err != nil {
+							errs = append(errs, fmt.Errorf("invalid enum directive for type %s: %w", g.Type, err))
+						}
+					}
+				}
+			}
+		}
+		return true
+	})
+	return errors.Join(errs...)
+}
+
+// SetOption sets a generation option by name, as used in "enum:" type directives.
+// Boolean options accept an empty value (meaning true), "true" or "false".
+// The "preset" option applies all options of the named preset set with SetPresets.
+func (g *Generator) SetOption(name, value string) error {
+	if name == "preset" {
+		return g.applyPreset(value)
+	}
+	if name == "name" {
+		return g.setNameOption(value)
+	}
+	if name == "split-tag" {
+		feature, tag, ok := strings.Cut(value, ":")
+		if !ok || feature == "" || tag == "" {
+			return fmt.Errorf("invalid split-tag %q, expected feature:constraint", value)
+		}
+		g.SetSplitTag(feature, tag)
+		return nil
+	}
+	if name == "method" {
+		methodName, list, ok := strings.Cut(value, ":")
+		values := strings.FieldsFunc(list, func(r rune) bool { return r == ',' })
+		if !ok || methodName == "" || len(values) == 0 {
+			return fmt.Errorf("invalid method %q, expected Name:Value,Value", value)
+		}
+		g.AddMethod(methodName, values...)
+		return nil
+	}
+	boolOptions := map[string]func(bool){
+		"lower":           g.SetLowerCase,
+		"getter":          g.SetGenerateGetter,
+		"sql":             g.SetGenerateSQL,
+		"ent":             g.SetGenerateEnt,
+		"sqlc":            g.SetGenerateSQLC,
+		"bson":            g.SetGenerateBSON,
+		"yaml":            g.SetGenerateYAML,
+		"toml":            g.SetGenerateTOML,
+		"pgx":             g.SetGeneratePgx,
+		"pg-enum":         g.SetGeneratePgEnum,
+		"split":           g.SetSplitFiles,
+		"flags":           g.SetGenerateFlags,
+		"set":             g.SetGenerateSet,
+		"wrap":            g.SetWrap,
+		"testhelpers":     g.SetGenerateTestHelpers,
+		"rapid":           g.SetGenerateRapid,
+		"tests":           g.SetGenerateTests,
+		"examples":        g.SetGenerateExamples,
+		"cross-package":   g.SetCrossPackage,
+		"unexported":      g.SetUnexported,
+		"jsonmap":         g.SetGenerateJSONMap,
+		"map":             g.SetGenerateMap,
+		"checked":         g.SetGenerateChecked,
+		"completion":      g.SetGenerateCompletion,
+		"env":             g.SetGenerateEnv,
+		"swag":            g.SetGenerateSwag,
+		"formatter":       g.SetGenerateFormatter,
+		"packed":          g.SetPacked,
+		"error-values":    g.SetErrorValues,
+		"null-strict":     g.SetNullStrict,
+		"graphql":         g.SetGenerateGraphQL,
+		"redis":           g.SetGenerateRedis,
+		"null":            g.SetGenerateNull,
+		"gqlgen":          g.SetGenerateGQLGenConfig,
+		"hide-deprecated": g.SetHideDeprecated,
+		"jsonexample":     g.SetGenerateJSONExample,
+		"info":            g.SetGenerateInfo,
+		"immutable":       g.SetImmutableValues,
+		"seq":             g.SetGenerateSeq,
+		"sorted":          g.SetGenerateSorted,
+		"descriptor":      g.SetGenerateDescriptor,
+		"registry":        g.SetGenerateRegistry,
+		"runtime":         g.SetRuntime,
+		"generic":         g.SetGeneric,
+		"jsonschema":      g.SetGenerateJSONSchema,
+		"openapi":         g.SetGenerateOpenAPI,
+		"marshal-number":  g.SetMarshalNumber,
+		"tolerant":        g.SetTolerant,
+	}
+	stringOptions := map[string]func(string){
+		"guard":            g.SetGuard,
+		"parse":            g.SetParseMode,
+		"existing-methods": g.SetExistingMethods,
+		"string-case":      g.SetStringCase,
+		"trim-prefix":      g.SetTrimPrefix,
+		"require-version":  g.SetRequireVersion,
+		"header":           g.SetHeader,
+		"output":           g.SetOutput,
+		"parse-name":       g.SetParseName,
+		"values-name":      g.SetValuesName,
+		"name-prefix":      g.SetNamePrefix,
+		"name-suffix":      g.SetNameSuffix,
+		"initialisms":      g.addInitialisms,
+		"translations":     g.SetTranslations,
+		"ts-out":           g.SetTypeScriptOut,
+		"proto-out":        g.SetProtoOut,
+		"ddl":              g.SetDDL,
+		"fallback":         g.SetFallback,
+		"null-value":       g.SetNullValue,
+	}
+	if set, ok := stringOptions[name]; ok {
+		set(value)
+		return nil
+	}
+	set, ok := boolOptions[name]
+	if !ok {
+		return fmt.Errorf("unknown option %q", name)
+	}
+	enabled := true
+	if value != "" {
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for option %q", value, name)
+		}
+		enabled = v
+	}
+	set(enabled)
+	return nil
+}
+
+// AnnotatedTypes returns names of types in the directory with an "enum:" directive in their doc comment,
+// sorted alphabetically. Only lowercase (private) types are returned. Files excluded by build constraints
+// for the current platform and the given build tags are skipped.
+func AnnotatedTypes(dir string, tags []string) ([]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, buildFilter(dir, tags), parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse directory: %w", err)
+	}
+
+	var res []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, d := range file.Decls {
+				decl, ok := d.(*ast.GenDecl)
+				if !ok || decl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					tspec, ok := spec.(*ast.TypeSpec)
+					if !ok || !unicode.IsLower(rune(tspec.Name.Name[0])) {
+						continue
+					}
+					if hasTypeDirective(decl, tspec) {
+						res = append(res, tspec.Name.Name)
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// typeDirectiveOptions returns options from "enum:" directives in the type doc comment, e.g.
+// "//enum: lower, sql, getter" returns ["lower", "sql", "getter"]. Options are separated by commas
+// or spaces, and the doc comment of a single-spec declaration is used if the spec has no own doc.
+// The comma-separated values of "method=IsTerminal:Blocked,Deleted" and "initialisms=SKU,VAT" are kept in one option.
+func typeDirectiveOptions(decl *ast.GenDecl, tspec *ast.TypeSpec) []string {
+	var res []string
+	for _, text := range typeDirectives(decl, tspec) {
+		for _, field := range strings.Fields(text) {
+			if strings.HasPrefix(field, "method=") || strings.HasPrefix(field, "initialisms=") {
+				res = append(res, strings.TrimSuffix(field, ","))
+				continue
+			}
+			res = append(res, strings.FieldsFunc(field, func(r rune) bool { return r == ',' })...)
+		}
+	}
+	return res
+}
+
+// hasTypeDirective checks if the type doc comment contains an "enum:" directive
+func hasTypeDirective(decl *ast.GenDecl, tspec *ast.TypeSpec) bool {
+	return len(typeDirectives(decl, tspec)) > 0
+}
+
+// typeDirectives returns the text after "enum:" for each directive line in the type doc comment
+func typeDirectives(decl *ast.GenDecl, tspec *ast.TypeSpec) []string {
+	doc := tspec.Doc
+	if doc == nil && len(decl.Specs) == 1 {
+		doc = decl.Doc
+	}
+	if doc == nil {
+		return nil
+	}
+	var res []string
+	for _, c := range doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		if rest, ok := strings.CutPrefix(text, "enum:"); ok {
+			res = append(res, strings.TrimSpace(rest))
+		}
+	}
+	return res
+}
+
+// parseConstBlock extracts enum values from a const block. Constants are selected by their type-checked
+// type, constants of other types and untyped ones are skipped whatever their names are.
+func (g *Generator) parseConstBlock(decl *ast.GenDecl) error {
+	// position of the block values among values of other blocks, from enum:order= above the block
+	order := parseDirectiveValue("order", decl.Doc)
+	g.logf(decl.Pos(), "const block with %d spec(s)", len(decl.Specs))
+
+	for _, spec := range decl.Specs {
+		vspec, ok := spec.(*ast.ValueSpec)
+		if !ok || len(vspec.Names) == 0 {
+			continue
+		}
+
+		// parse aliases from inline comment (vspec.Comment is the inline comment)
+		aliases := parseAliasComment(vspec.Comment)
+
+		// extract free-text comment: inline takes priority, doc comment is fallback
+		comment := parseDocComment(vspec.Comment)
+		if comment == "" {
+			comment = parseDocComment(vspec.Doc)
+		}
+
+		// description for UIs and API docs, enum:desc= takes the rest of the line and overrides the comment
+		desc, _ := parseTextDirective("desc", vspec.Comment, vspec.Doc)
+
+		// deprecated values with an optional reason from enum:deprecated[=reason]
+		deprecation, deprecated := parseTextDirective("deprecated", vspec.Comment, vspec.Doc)
+
+		// versioned availability from enum:since= and enum:until= in inline or doc comment
+		since := parseDirectiveValue("since", vspec.Comment, vspec.Doc)
+		until := parseDirectiveValue("until", vspec.Comment, vspec.Doc)
+
+		// exported name override from enum:public=, applies to the first name of the spec only
+		public := parseDirectiveValue("public", vspec.Comment, vspec.Doc)
+
+		// string form override from enum:name=, e.g. for wire names with dashes, applies to the first name as well
+		pinnedName := parseDirectiveValue("name", vspec.Comment, vspec.Doc)
+
+		// process all names in this spec
+		for _, name := range vspec.Names {
+			// skip underscore placeholders
+			if name.Name == "_" {
+				g.logf(name.Pos(), "_ skipped, blank identifier")
+				continue
+			}
+
+			def := g.typesInfo.Defs[name]
+			if def == nil || def.Type() == types.Typ[types.Invalid] {
+				return fmt.Errorf("constant %s can't be type-checked: %w", name.Name, g.typeErr)
+			}
+			obj, ok := def.(*types.Const)
+			if !ok || g.enumType == nil || !types.Identical(obj.Type(), g.enumType) {
+				if ok {
+					g.logf(name.Pos(), "%s skipped, constant of type %s", name.Name, obj.Type())
+				}
+				continue
+			}
+			if obj.Val().Kind() == constant.Unknown {
+				return fmt.Errorf("value of %s can't be evaluated: %w", name.Name, g.typeErr)
+			}
+			enumValue, str := 0, ""
+			switch val := obj.Val(); val.Kind() {
+			case constant.String:
+				str = constant.StringVal(val)
+			default:
+				v, exact := constant.Int64Val(constant.ToInt(val))
+				if !exact {
+					return fmt.Errorf("value %s of %s is not an integer in int64 range", val, name.Name)
+				}
+				enumValue = int(v)
+			}
+			g.logf(name.Pos(), "%s matched, value %s", name.Name, obj.Val())
+
+			// store the value with its position, aliases, and comment
+			g.values[name.Name] = &constValue{
+				value:   enumValue,
+				str:     str,
+				pos:     name.Pos(),
+				aliases: aliases,
+				comment: comment,
+				desc:    desc,
+				since:   since,
+				until:   until,
+				public:  public,
+				name:    pinnedName,
+				order:   order,
+
+				deprecated:  deprecated,
+				deprecation: deprecation,
+			}
+			public, pinnedName = "", "" // a pinned name can't be shared by several constants
+		}
+	}
+	return nil
+}
+
+// stringBacked reports whether the enum type has a string underlying type, e.g. "type role string"
+func (g *Generator) stringBacked() bool {
+	return g.underlyingType == "string"
+}
+
+// buildFilter returns a ParseDir filter accepting files which match build constraints for the current
+// platform and the given build tags. Files with unreadable constraints are accepted and fail in the parser.
+func buildFilter(dir string, tags []string) func(fs.FileInfo) bool {
+	ctx := build.Default
+	ctx.BuildTags = append(slices.Clone(ctx.BuildTags), tags...)
+	return func(fi fs.FileInfo) bool {
+		match, err := ctx.MatchFile(dir, fi.Name())
+		return match || err != nil
+	}
+}
+
+// typeCheck type-checks the package, so constant values come from go/constant and the enum constants
+// can be selected by type. Imported packages are loaded from export data with go/packages, resolved from
+// the source directory with the build tags set. Type errors don't fail the check, constants not depending
+// on the broken declarations keep their values; the first error is kept to report unresolved enum values.
+func (g *Generator) typeCheck(fset *token.FileSet, pkg *ast.Package) error { //nolint:staticcheck // ast.Package is what ParseDir returns
+	files := make([]*ast.File, 0, len(pkg.Files))
+	for _, name := range sortedKeys(pkg.Files) {
+		files = append(files, pkg.Files[name])
+	}
+	imp, err := g.loadImports(fset, files)
+	if err != nil {
+		return err
+	}
+	g.typeErr = nil
+	g.typesInfo = &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	conf := types.Config{Importer: imp, Error: func(err error) {
+		if g.typeErr == nil {
+			g.typeErr = err
+		}
+	}}
+	tpkg, _ := conf.Check(pkg.Name, fset, files, g.typesInfo) // errors are collected by conf.Error
+	g.enumType = nil
+	if tn, ok := tpkg.Scope().Lookup(g.Type).(*types.TypeName); ok {
+		g.enumType = tn.Type()
+	}
+	return nil
+}
+
+// loadImports loads the packages imported by the files with go/packages in a single call
+func (g *Generator) loadImports(fset *token.FileSet, files []*ast.File) (packagesImporter, error) {
+	var paths []string
+	for _, file := range files {
+		for _, spec := range file.Imports {
+			if path, err := strconv.Unquote(spec.Path.Value); err == nil && path != "unsafe" && !slices.Contains(paths, path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	imp := packagesImporter{}
+	if len(paths) == 0 {
+		return imp, nil
+	}
+	cfg := &packages.Config{Mode: packages.NeedName | packages.NeedTypes, Dir: g.srcDir, Fset: fset}
+	if len(g.buildTags) > 0 {
+		cfg.BuildFlags = []string{"-tags=" + strings.Join(g.buildTags, ",")}
+	}
+	pkgs, err := packages.Load(cfg, paths...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load imported packages: %w", err)
+	}
+	for _, p := range pkgs {
+		if p.Types != nil && len(p.Errors) == 0 {
+			imp[p.PkgPath] = p.Types
+		}
+	}
+	return imp, nil
+}
+
+// packagesImporter imports packages loaded by go/packages, keyed by import path
+type packagesImporter map[string]*types.Package
+
+// Import returns the loaded package for the path, packages failed to load are reported as type errors
+func (imp packagesImporter) Import(path string) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	if pkg, ok := imp[path]; ok {
+		return pkg, nil
+	}
+	return nil, fmt.Errorf("can't import package %s", path)
+}
+
+// sortedKeys returns map keys in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Generate creates the enum code file. it takes the const values found in Parse and creates
+// a new type with json, sql and text marshaling support. the generated code includes:
+//   - exported type with private name and value fields (e.g., Status{name: "active", value: 1})
+//   - string representation (String method)
+//   - text marshaling (MarshalText/UnmarshalText methods)
+//   - sql marshaling (Value/Scan methods for driver.Valuer and sql.Scanner)
+//   - parsing functions (Parse/Must variants)
+//   - exported const values (e.g., StatusActive)
+//   - helper functions to get all values and names
+func (g *Generator) Generate() error {
+	files, stale, err := g.render()
+	if err != nil {
+		return err
+	}
+	if err := keepCustomRegions(g.Path, files); err != nil {
+		return err
+	}
+	return g.writeFiles(files, stale)
+}
+
+// GenerateTo writes the generated code to w instead of a file, e.g. to review or pipe it to other tools,
+// or to capture it in memory in tests and embedding tools. The formatted source is the same as Generate
+// writes to the main file, except that custom regions of the file on disk are not merged in. Nothing
+// is written and the output path is not created. Split output is not supported, as the code is written
+// as a single source, and other files, like the JSON schema, are not produced.
+func (g *Generator) GenerateTo(w io.Writer) error {
+	if g.splitFiles {
+		return fmt.Errorf("split output of type %s can't be written as a single source", g.Type)
+	}
+	files, _, err := g.render()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(files[0].src); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
+
+// Check regenerates the code in memory and compares it with the files in the output path, without writing
+// anything. It returns a description of each file which is missing, differs from the generated code or
+// is left from a previous generation, empty if all files are up to date.
+func (g *Generator) Check() ([]string, error) {
+	files, stale, err := g.render()
+	if err != nil {
+		return nil, err
+	}
+	if err := keepCustomRegions(g.Path, files); err != nil {
+		return nil, err
+	}
+	return checkFiles(g.Path, files, stale)
+}
+
+// checkFiles compares the generated files with the ones in dir and reports the generated files in stale
+// which still exist
+func checkFiles(dir string, files []generatedFile, stale []string) ([]string, error) {
+	var res []string
+	for _, f := range files {
+		path := outputPath(dir, f.name)
+		data, err := os.ReadFile(path) //nolint:gosec // path is built from the output dir and type name
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			res = append(res, path+": missing")
+		case err != nil:
+			return nil, fmt.Errorf("failed to read generated file: %w", err)
+		case !bytes.Equal(data, f.src):
+			res = append(res, path+": out of date")
+		}
+	}
+	for _, name := range stale {
+		path := outputPath(dir, name)
+		data, err := os.ReadFile(path) //nolint:gosec // path is built from the output dir and type name
+		if err == nil && isGeneratedFile(data) {
+			res = append(res, path+": stale, should be removed")
+		}
+	}
+	return res, nil
+}
+
+// OutputFile describes a file written or removed by a generation
+type OutputFile struct {
+	Path    string `json:"path"`              // path of the file in the output directory
+	SHA256  string `json:"sha256,omitempty"`  // hex-encoded SHA-256 of the written content, empty for removed files
+	Changed bool   `json:"changed"`           // content differs from the file before the generation
+	Removed bool   `json:"removed,omitempty"` // stale generated file removed
+}
+
+// Output returns files written and removed by the last Generate, or GenerateCombined called with the
+// generator first. It's empty if nothing was written yet.
+func (g *Generator) Output() []OutputFile { return g.output }
+
+// Generated reports whether code previously written by the generator into the parsed directory declares
+// the package-level name, e.g. NewStatusFromInt if the type was generated with SetGenerateChecked
+func (g *Generator) Generated(name string) bool { return g.generated[name] }
+
+// outputPath returns the path of the generated file in the output dir, absolute names are kept as is
+func outputPath(dir, name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(dir, name)
+}
+
+// generatedFile is a rendered output file
+type generatedFile struct {
+	name string // file name, relative to the output path, or absolute for files written elsewhere
+	src  []byte // formatted source, or content of non-Go files like the JSON schema
+}
+
+// render validates the parsed values and renders the output files, the main file first. It returns
+// the files and names of feature files from a previous split generation which are not produced anymore.
+func (g *Generator) render() (files []generatedFile, stale []string, err error) {
+	if err := g.checkVersion(); err != nil {
+		return nil, nil, err
+	}
+
+	// validate aliases: no duplicates and no conflicts with canonical names
+	if err := g.validateAliases(); err != nil {
+		return nil, nil, err
+	}
+
+	// to avoid an undefined behavior for a Getter, we need to check if the values are unique
+	if g.generateGetter {
+		valuesCounter := make(map[string][]string)
+		// check if multiple names exist for the same value
+		for name, cv := range g.values {
+			lit := g.valueLiteral(cv)
+			if _, ok := valuesCounter[lit]; !ok {
+				valuesCounter[lit] = []string{}
+			}
+			valuesCounter[lit] = append(valuesCounter[lit], name)
+		}
+		var errs []error
+		for _, val := range sortedKeys(valuesCounter) {
+			if names := valuesCounter[val]; len(names) > 1 {
+				slices.Sort(names)
+				errs = append(errs, &DuplicateValueError{Type: g.Type, Value: val, Consts: names})
+			}
+		}
+		if len(errs) > 0 {
+			return nil, nil, errors.Join(errs...)
+		}
+	}
+
+	if err := g.checkStringCase(); err != nil {
+		return nil, nil, err
+	}
+
+	values := g.buildValues()
+
+	if err := validateVersions(values); err != nil {
+		return nil, nil, err
+	}
+
+	if err := g.validateOrder(values); err != nil {
+		return nil, nil, err
+	}
+
+	if err := g.validateNames(values); err != nil {
+		return nil, nil, err
+	}
+
+	if err := g.validatePublicNames(values); err != nil {
+		return nil, nil, err
+	}
+
+	methods, err := g.buildMethods(values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fallback, nullValue string
+	if g.fallback != "" {
+		if fallback = g.findValue(values, g.fallback); fallback == "" {
+			return nil, nil, fmt.Errorf("fallback: unknown value %s of type %s", g.fallback, g.Type)
+		}
+	}
+	if g.nullValue != "" {
+		if g.nullStrict {
+			return nil, nil, fmt.Errorf("null-value and null-strict are mutually exclusive")
+		}
+		if nullValue = g.findValue(values, g.nullValue); nullValue == "" {
+			return nil, nil, fmt.Errorf("null-value: unknown value %s of type %s", g.nullValue, g.Type)
+		}
+	}
+
+	// string values and pinned names are used in generated string literals as is
+	var escErrs []error
+	for _, v := range values {
+		if strconv.Quote(v.Name) == `"`+v.Name+`"` {
+			continue
+		}
+		if cv := g.values[v.PrivateName]; cv != nil && cv.name != "" {
+			escErrs = append(escErrs, fmt.Errorf("name %q of %s contains characters requiring escaping", v.Name, v.PrivateName))
+			continue
+		}
+		escErrs = append(escErrs, fmt.Errorf("string value %s of %s contains characters requiring escaping", v.Literal, v.PrivateName))
+	}
+	if len(escErrs) > 0 {
+		return nil, nil, errors.Join(escErrs...)
+	}
+
+	if g.marshalNumber && g.stringBacked() {
+		return nil, nil, fmt.Errorf("marshal-number is not supported for string-backed type %s", g.Type)
+	}
+	if g.tolerant && g.stringBacked() {
+		return nil, nil, fmt.Errorf("tolerant is not supported for string-backed type %s", g.Type)
+	}
+
+	// flags combine values as bits, so each value must be a single bit
+	if g.generateFlags && g.stringBacked() {
+		return nil, nil, fmt.Errorf("flags are not supported for string-backed type %s", g.Type)
+	}
+	if g.generateFlags {
+		var errs []error
+		for _, v := range values {
+			if v.Index < 0 || v.Index&(v.Index-1) != 0 {
+				errs = append(errs, fmt.Errorf("flags value %s must be zero or a power of two, got %d", v.PrivateName, v.Index))
+			}
+		}
+		if len(errs) > 0 {
+			return nil, nil, errors.Join(errs...)
+		}
+	}
+
+	guard := cmp.Or(g.guard, "func")
+	if !slices.Contains([]string{"func", "var", "none"}, guard) {
+		return nil, nil, fmt.Errorf("invalid guard %q, expected func, var or none", g.guard)
+	}
+
+	if err := g.checkUnexported(); err != nil {
+		return nil, nil, err
+	}
+	if err := g.checkRuntime(); err != nil {
+		return nil, nil, err
+	}
+	if err := g.checkGeneric(); err != nil {
+		return nil, nil, err
+	}
+
+	// in cross-package mode the constants aren't visible from the output package, the guard goes to the bridge file
+	mainGuard, bridgeDir := guard, ""
+	if g.crossPackage {
+		if bridgeDir, err = g.bridgeDir(); err != nil {
+			return nil, nil, err
+		}
+		mainGuard = "none"
+	}
+
+	parseMode := cmp.Or(g.parseMode, "map")
+	if !slices.Contains([]string{"map", "lazy", "switch"}, parseMode) {
+		return nil, nil, fmt.Errorf("invalid parse mode %q, expected map, lazy or switch", g.parseMode)
+	}
+	existingMethods := cmp.Or(g.existingMethods, "fail")
+	if !slices.Contains([]string{"fail", "skip"}, existingMethods) {
+		return nil, nil, fmt.Errorf("invalid existing methods mode %q, expected fail or skip", g.existingMethods)
+	}
+
+	translations, err := g.buildTranslations(values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if g.generateGraphQL || g.generateGQLGen {
+		if err := setGraphQLNames(g.exportedName(g.Type), values); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// determine output package name: use directory name if path is set
+	pkgName := g.pkgName
+	if g.Path != "" {
+		dir := filepath.Base(g.Path)
+		// ensure package name is a valid go identifier
+		if !isValidGoIdentifier(dir) || token.IsKeyword(dir) {
+			pkgName = "enum" // fallback to a safe name
+		} else {
+			pkgName = dir
+		}
+	}
+
+	// prepare template data
+	data := TemplateData{
+		Type:                g.Type,
+		GoType:              cmp.Or(g.names[titleCaser.String(g.Type)], g.exportedName(g.Type)),
+		Values:              values,
+		Package:             pkgName,
+		LowerCase:           g.lowerCase,
+		GenerateGetter:      g.generateGetter,
+		UnderlyingType:      g.underlyingType,
+		GenerateSQL:         g.sqlInterfaces(),
+		GenerateBSON:        g.generateBSON,
+		GenerateYAML:        g.generateYAML,
+		GenerateTOML:        g.generateTOML,
+		GeneratePgx:         g.generatePgx,
+		SQLTypeName:         g.snakeName(g.Type),
+		SplitFiles:          g.splitFiles,
+		GenerateFlags:       g.generateFlags,
+		GenerateSet:         g.generateSet,
+		SetWords:            (len(values) + 63) / 64,
+		Wrap:                g.wrap,
+		GenerateRapid:       g.generateRapid,
+		GenerateJSONMap:     g.generateJSONMap,
+		GenerateMap:         g.generateMap,
+		GenerateChecked:     g.generateChecked,
+		GenerateCompletion:  g.generateCompletion,
+		GenerateEnv:         g.generateEnv,
+		GenerateFormatter:   g.generateFormatter,
+		GenerateGraphQL:     g.generateGraphQL,
+		GenerateRedis:       g.generateRedis,
+		GenerateNull:        g.generateNull,
+		GenerateEnt:         g.generateEnt,
+		GenerateJSONExample: g.generateJSONExample,
+		GenerateInfo:        g.generateInfo,
+		ImmutableValues:     g.immutableValues,
+		GenerateDescriptor:  g.generateDescriptor || g.generateRegistry,
+		GenerateSeq:         g.generateSeq,
+		Generic:             g.generic,
+		ValuesVar:           titleCaser.String(g.Type) + "Values",
+		Guard:               mainGuard,
+		Unexported:          g.unexported,
+		MarshalNumber:       g.marshalNumber,
+		ParseMode:           parseMode,
+		ParseMaxLen:         parseMaxLen(values),
+		ParseCases:          parseCases(values),
+		Tolerant:            g.tolerant,
+		UniqueValues:        uniqueValues(values),
+		StringBacked:        g.stringBacked(),
+		ZeroLiteral:         g.valueLiteral(&constValue{}),
+		HasVersions:         hasVersions(values),
+		HasAliases:          slices.ContainsFunc(values, func(v Value) bool { return len(v.Aliases) > 0 }),
+		HasDescriptions:     slices.ContainsFunc(values, func(v Value) bool { return v.Description != "" }),
+		HasDeprecated:       slices.ContainsFunc(values, func(v Value) bool { return v.Deprecated }),
+		HideDeprecated:      g.hideDeprecated,
+		Translations:        translations,
+		Methods:             methods,
+		Fallback:            fallback,
+		NullValue:           nullValue,
+		NullStrict:          g.nullStrict,
+	}
+	if g.immutableValues {
+		data.ValuesVar = "_" + g.Type + "Values"
+	}
+	if g.generateSorted {
+		data.ByValue, data.ByName = sortedNames(values, g.stringBacked())
+	}
+	if g.generateRegistry {
+		importPath, err := packageImportPath(cmp.Or(g.Path, "."))
+		if err != nil {
+			return nil, nil, fmt.Errorf("registry: %w", err)
+		}
+		data.RegistryName = importPath + "." + data.GoType
+	}
+
+	if !data.StringBacked {
+		data.MinValue, data.MaxValue = valueBounds(values)
+	}
+	if g.generateSwag {
+		data.SwagEnums = swagEnums(values, g.lowerCase, g.marshalNumber, g.hideDeprecated)
+	}
+	if g.errorValues {
+		data.ErrorValues = errorValues(values, g.lowerCase, g.hideDeprecated)
+	}
+	if g.packed {
+		data.Packed = packNames(values, g.lowerCase)
+		data.NameCall = "()"
+	}
+
+	// the schema is rendered first, its content is embedded in the Go code as well
+	var schema []byte
+	if g.generateJSONSchema {
+		if schema, err = renderJSONSchema(data); err != nil {
+			return nil, nil, err
+		}
+		data.JSONSchema = string(schema)
+	}
+
+	tmpl, mainTemplate, err := g.loadTemplate()
+	if err != nil {
+		return nil, nil, err
+	}
+	if g.runtime {
+		mainTemplate = "runtime_file"
+	}
+
+	// collect output files, the main file and optionally one file per enabled feature
+	type output struct {
+		name     string // file name
+		template string // template name, empty for the main template
+		build    string // build constraint of the file, empty if none
+	}
+	fileName, err := g.fileName()
+	if err != nil {
+		return nil, nil, err
+	}
+	outputs := []output{{name: fileName}}
+	if def := getFileNameForType(g.Type); fileName != def {
+		stale = append(stale, def) // generated before with the default name
+	}
+	constraints, err := g.splitConstraints()
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, f := range []struct {
+		name    string
+		enabled bool
+	}{{"sql", g.sqlInterfaces()}, {"bson", g.generateBSON}, {"yaml", g.generateYAML}, {"toml", g.generateTOML}, {"pgx", g.generatePgx}} {
+		featureFile := getFeatureFileName(fileName, f.name)
+		if g.splitFiles && f.enabled {
+			outputs = append(outputs, output{name: featureFile, template: f.name + "_file", build: constraints[f.name]})
+			continue
+		}
+		stale = append(stale, featureFile)
+	}
+	if g.generateTestHelpers || g.generateRapid {
+		outputs = append(outputs, output{name: getTestHelpersFileName(fileName), template: "testhelpers_file"})
+	} else {
+		stale = append(stale, getTestHelpersFileName(fileName))
+	}
+	if g.generateTests {
+		// the name is common for hand-written tests, don't overwrite them
+		previous, err := readPrevious(g.Path, getTestsFileName(fileName))
+		if err != nil {
+			return nil, nil, err
+		}
+		if previous != nil && !isGeneratedFile(previous) {
+			return nil, nil, fmt.Errorf("%s exists and is not generated, rename it to generate tests", getTestsFileName(fileName))
+		}
+		outputs = append(outputs, output{name: getTestsFileName(fileName), template: "tests_file"})
+	} else {
+		stale = append(stale, getTestsFileName(fileName))
+	}
+	if g.generateExamples {
+		outputs = append(outputs, output{name: getExamplesFileName(fileName), template: "examples_file"})
+	} else {
+		stale = append(stale, getExamplesFileName(fileName))
+	}
+
+	header, err := g.renderHeader(g.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// render all outputs before writing anything
+	for _, out := range outputs {
+		var buf bytes.Buffer
+		execute := func() error { return tmpl.Execute(&buf, data) }
+		if mainTemplate != "" {
+			execute = func() error { return tmpl.ExecuteTemplate(&buf, mainTemplate, data) }
+		}
+		if out.template != "" {
+			execute = func() error { return tmpl.ExecuteTemplate(&buf, out.template, data) }
+		}
+		if err := execute(); err != nil {
+			return nil, nil, fmt.Errorf("failed to execute template: %w", err)
+		}
+
+		// format generated code
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to format source: %w", err)
+		}
+		files = append(files, generatedFile{name: out.name, src: src})
+	}
+	// names are checked for collisions with the package even if not renamed
+	if err := g.renameNames(files, values); err != nil {
+		return nil, nil, err
+	}
+	if err := g.skipExistingMethods(files, existingMethods == "skip"); err != nil {
+		return nil, nil, err
+	}
+	for i, out := range outputs {
+		// the build constraint goes first, above the license header
+		var build []byte
+		if out.build != "" {
+			build = []byte("//go:build " + out.build + "\n\n")
+		}
+		files[i].src = slices.Concat(build, header, files[i].src)
+	}
+
+	if bridgeDir != "" {
+		bridgeFile := filepath.Join(bridgeDir, getBridgeFileName(fileName))
+		if guard == "none" {
+			stale = append(stale, bridgeFile)
+		} else {
+			bridge := data
+			bridge.Package, bridge.Guard = g.pkgName, guard
+			var buf bytes.Buffer
+			if err := tmpl.ExecuteTemplate(&buf, "bridge_file", bridge); err != nil {
+				return nil, nil, fmt.Errorf("failed to execute template: %w", err)
+			}
+			src, err := format.Source(buf.Bytes())
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to format source: %w", err)
+			}
+			files = append(files, generatedFile{name: bridgeFile, src: slices.Concat(header, src)})
+		}
+	}
+
+	if schema != nil {
+		files = append(files, generatedFile{name: getSchemaFileName(fileName), src: schema})
+	} else {
+		stale = append(stale, getSchemaFileName(fileName))
+	}
+	if g.generateOpenAPI {
+		openAPI, err := renderOpenAPI(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, generatedFile{name: getOpenAPIFileName(fileName), src: openAPI})
+	} else {
+		stale = append(stale, getOpenAPIFileName(fileName))
+	}
+	if g.generateGQLGen {
+		importPath, err := packageImportPath(cmp.Or(g.Path, "."))
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, generatedFile{name: getGQLGenFileName(fileName), src: renderGQLGenConfig(data, importPath)})
+	} else {
+		stale = append(stale, getGQLGenFileName(fileName))
+	}
+	if g.generateSQLC {
+		importPath, err := packageImportPath(cmp.Or(g.Path, "."))
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, generatedFile{name: getSQLCFileName(fileName), src: renderSQLC(data, importPath)})
+	} else {
+		stale = append(stale, getSQLCFileName(fileName))
+	}
+	if g.generatePgEnum {
+		previous, err := readPrevious(g.Path, getPgEnumFileName(fileName))
+		if err != nil {
+			return nil, nil, err
+		}
+		pgEnum, err := renderPgEnum(data, previous)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, generatedFile{name: getPgEnumFileName(fileName), src: pgEnum})
+	} else {
+		stale = append(stale, getPgEnumFileName(fileName))
+	}
+	if g.ddl != "" {
+		ddl, err := renderDDL(data, g.ddl)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, generatedFile{name: getDDLFileName(fileName), src: ddl})
+	} else {
+		stale = append(stale, getDDLFileName(fileName))
+	}
+
+	// TypeScript and protobuf definitions go to other directories, the names are absolute
+	if g.tsOut != "" {
+		ts, err := renderTypeScript(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, generatedFile{name: filepath.Join(g.tsOut, getTypeScriptFileName(fileName)), src: ts})
+	}
+	if g.protoOut != "" {
+		proto, err := renderProto(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		files = append(files, generatedFile{name: filepath.Join(g.protoOut, getProtoFileName(fileName)), src: proto})
+	}
+	return files, stale, nil
+}
+
+// writeFiles writes the files to the output path, creating it if needed, and removes the stale
+// generated files. Files in stale which were not generated by enum are kept.
+func (g *Generator) writeFiles(files []generatedFile, stale []string) error {
+	// ensure output directory exists
+	if g.Path != "" {
+		// get source directory permissions or use 0o755 as fallback
+		dirPerm := os.FileMode(0o755)
+		if info, err := os.Stat(filepath.Dir(g.Path)); err == nil && info.IsDir() {
+			dirPerm = info.Mode().Perm()
+		}
+
+		if err := os.MkdirAll(g.Path, dirPerm); err != nil {
+			return fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	// use source file permissions or 0o644 as fallback
+	filePerm := os.FileMode(0o644)
+
+	// write generated code to files
+	g.output = nil
+	for _, f := range files {
+		path := outputPath(g.Path, f.name)
+		if filepath.IsAbs(f.name) {
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:gosec // shared source directory
+				return fmt.Errorf("failed to create output directory: %w", err)
+			}
+		}
+		prev, _ := os.ReadFile(path) //nolint:gosec // path is built from the output dir and type name
+		if err := os.WriteFile(path, f.src, filePerm); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		sum := sha256.Sum256(f.src)
+		g.output = append(g.output, OutputFile{Path: path, SHA256: hex.EncodeToString(sum[:]), Changed: !bytes.Equal(prev, f.src)})
+	}
+
+	// remove files left from a previous generation, they would redeclare methods
+	for _, name := range stale {
+		path := outputPath(g.Path, name)
+		removed, err := removeGeneratedFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to remove stale output file: %w", err)
+		}
+		if removed {
+			g.output = append(g.output, OutputFile{Path: path, Changed: true, Removed: true})
+		}
+	}
+
+	return nil
+}
+
+// buildValues converts parsed constants to template values, sorted by source position
+// to preserve declaration order
+func (g *Generator) buildValues() []Value {
+	// collect entries for sorting by position
+	type entry struct {
+		name string
+		cv   *constValue
+	}
+	entries := make([]entry, 0, len(g.values))
+	for name, cv := range g.values {
+		entries = append(entries, entry{name: name, cv: cv})
+	}
+
+	// sort by block order from enum:order=, then by source position to preserve declaration order
+	sort.Slice(entries, func(i, j int) bool {
+		if oi, oj := entries[i].cv.blockOrder(), entries[j].cv.blockOrder(); oi != oj {
+			return oi < oj
+		}
+		return entries[i].cv.pos < entries[j].cv.pos
+	})
+
+	// create values with proper name transformations for each case
+	values := make([]Value, 0, len(entries))
+	for _, e := range entries {
+		privateName := e.name
+		// strip type prefix to get just the value name part (e.g., "Active" from "statusActive")
+		nameWithoutPrefix := strings.TrimPrefix(g.trimName(privateName), g.Type)
+		publicName := g.publicValueName(privateName, e.cv)
+		name := g.caseName(titleCaser.String(nameWithoutPrefix))
+		if g.stringBacked() {
+			name = e.cv.str // string-backed enums use the constant value as the name
+		}
+		if e.cv.name != "" {
+			name = e.cv.name
+		}
+		values = append(values, Value{
+			PrivateName: privateName,
+			PublicName:  publicName,
+			Name:        name,
+			Index:       e.cv.value,
+			Literal:     g.valueLiteral(e.cv),
+			Aliases:     e.cv.aliases,
+			Comment:     e.cv.comment,
+			Description: cmp.Or(e.cv.desc, e.cv.comment),
+			Since:       e.cv.since,
+			Until:       e.cv.until,
+			Deprecated:  e.cv.deprecated,
+			Deprecation: e.cv.deprecation,
+		})
+	}
+	return values
+}
+
+// publicValueName returns the exported constant name of the value, the name pinned with enum:public=
+// or the private name with title-cased type (e.g., "StatusActive" for "statusActive"), without the trim prefix,
+// with initialisms upper-cased if set (e.g., "SKUStatusActive" for "skuStatusActive")
+func (g *Generator) publicValueName(privateName string, cv *constValue) string {
+	if cv != nil && cv.public != "" {
+		return cv.public
+	}
+	if !strings.HasPrefix(privateName, g.Type) {
+		privateName = g.Type + titleCaser.String(privateName) // e.g. "Active" of type "status"
+	}
+	privateName = g.trimName(privateName)
+	if len(g.initialisms) > 0 {
+		return g.exportedName(privateName)
+	}
+	return titleCaser.String(g.Type) + strings.TrimPrefix(privateName, g.Type)
+}
+
+// valueLiteral returns Go literal of the constant value, quoted string for string-backed enums
+func (g *Generator) valueLiteral(cv *constValue) string {
+	if g.stringBacked() {
+		return strconv.Quote(cv.str)
+	}
+	return strconv.Itoa(cv.value)
+}
+
+// splitCamelCase splits a camel case string into words, it handles the sequential abbreviations
+// and acronyms by treating them as single words.
+// For example:
+// "jobStatus" becomes ["job", "Status"].
+// "internalIPAddress" becomes ["internal", "IP", "Address"].
+// "internalIP" becomes ["internal", "IP"].
+// "HTTPResponse" becomes ["HTTP", "Response"].
+// "HTTP" is not split further.
+func splitCamelCase(s string) []string {
+	var words []string
+	start := 0
+	var prev rune
+	for i, curr := range s {
+		if i == 0 {
+			prev = curr
+			continue
+		}
+		_, width := utf8.DecodeRuneInString(s[i:])
+		var next *rune
+		if i+width < len(s) {
+			nextr, _ := utf8.DecodeRuneInString(s[i+width:])
+			next = &nextr
+		}
+		if (unicode.IsLower(prev) && unicode.IsUpper(curr)) ||
+			(unicode.IsUpper(curr) && (next != nil && unicode.IsLower(*next))) {
+			words = append(words, s[start:i])
+			start = i
+		}
+		prev = curr
+	}
+	words = append(words, s[start:])
+	return words
+}
+
+// getFileNameForType returns the file name for the generated enum code based on the type name.
+// It converts the type name to snake case and appends "_enum.go" to it.
+// For example, if the type name is "jobStatus", the file name will be "job_status_enum.go".
+func getFileNameForType(typeName string) string {
+	words := splitCamelCase(typeName)
+	for i := range words {
+		words[i] = strings.ToLower(words[i])
+	}
+
+	return strings.Join(words, "_") + "_enum.go"
+}
+
+// sqlTypeName returns the name of the type in databases, the type name in snake case, e.g. "job_status"
+func sqlTypeName(typeName string) string {
+	return strings.TrimSuffix(getFileNameForType(typeName), "_enum.go")
+}
+
+// uniqueValues reports whether all values are distinct
+func uniqueValues(values []Value) bool {
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if seen[v.Literal] {
+			return false
+		}
+		seen[v.Literal] = true
+	}
+	return true
+}
+
+// parseCases returns names and aliases of the values in declaration order, for the case-insensitive switch
+// of Parse. Aliases equal to the name in another case are skipped.
+func parseCases(values []Value) []ParseCase {
+	res := make([]ParseCase, 0, len(values))
+	for _, v := range values {
+		names := []string{v.Name}
+		for _, alias := range v.Aliases {
+			if !slices.ContainsFunc(names, func(n string) bool { return strings.EqualFold(n, alias) }) {
+				names = append(names, alias)
+			}
+		}
+		res = append(res, ParseCase{PublicName: v.PublicName, Names: names})
+	}
+	return res
+}
+
+// parseMaxLen returns the length of the longest name or alias of the values in lower case, in bytes
+func parseMaxLen(values []Value) int {
+	res := 0
+	for _, v := range values {
+		for _, name := range append([]string{v.Name}, v.Aliases...) {
+			res = max(res, len(strings.ToLower(name)))
+		}
+	}
+	return res
+}
+
+// findValue returns the public name of the value given by its name in any case, or private or public
+// constant name, e.g. "unknown", "statusUnknown" or "StatusUnknown", empty if there is no such value
+func (g *Generator) findValue(values []Value, name string) string {
+	idx := slices.IndexFunc(values, func(v Value) bool {
+		return strings.EqualFold(v.Name, name) || v.PrivateName == g.privateValueName(name) || v.PublicName == name
+	})
+	if idx < 0 {
+		return ""
+	}
+	return values[idx].PublicName
+}
+
+// swagEnums returns values joined with commas as they are marshaled, names or numbers with marshalNumber,
+// for Enums() of swaggo annotations and enums struct tags. Deprecated values are skipped if hideDeprecated is set.
+func swagEnums(values []Value, lowerCase, marshalNumber, hideDeprecated bool) string {
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		switch {
+		case hideDeprecated && v.Deprecated:
+		case marshalNumber:
+			res = append(res, strconv.Itoa(v.Index))
+		case lowerCase:
+			res = append(res, strings.ToLower(v.Name))
+		default:
+			res = append(res, v.Name)
+		}
+	}
+	return strings.Join(res, ",")
+}
+
+// errorValuesLimit is the number of valid names listed in parse errors, the rest are counted
+const errorValuesLimit = 20
+
+// errorValues returns the comma-separated list of valid names and aliases for parse errors, as they are stored,
+// escaped for fmt format strings. Deprecated values are skipped if hideDeprecated is set.
+func errorValues(values []Value, lowerCase, hideDeprecated bool) string {
+	var names []string
+	for _, v := range values {
+		if hideDeprecated && v.Deprecated {
+			continue
+		}
+		name := v.Name
+		if lowerCase {
+			name = strings.ToLower(name)
+		}
+		names = append(names, name)
+		for _, alias := range v.Aliases {
+			if !strings.EqualFold(alias, v.Name) {
+				names = append(names, alias)
+			}
+		}
+	}
+	res := strings.Join(names, ", ")
+	if len(names) > errorValuesLimit {
+		res = strings.Join(names[:errorValuesLimit], ", ") + fmt.Sprintf(" and %d more", len(names)-errorValuesLimit)
+	}
+	return strings.ReplaceAll(res, "%", "%%")
+}
+
+// packNames concatenates names of the values as they are stored, lower case if lowerCase is set
+func packNames(values []Value, lowerCase bool) *PackedNames {
+	res := &PackedNames{Index: []int{0, 0}, Values: make([]PackedValue, 0, len(values))}
+	var sb strings.Builder
+	for i, v := range values {
+		name := v.Name
+		if lowerCase {
+			name = strings.ToLower(name)
+		}
+		start := sb.Len()
+		sb.WriteString(name)
+		res.Index = append(res.Index, sb.Len())
+		res.Values = append(res.Values, PackedValue{Ord: i + 1, Start: start, End: sb.Len()})
+	}
+	res.Names = sb.String()
+	res.IndexType = uintType(uint64(len(res.Names)))
+	res.OrdType = uintType(uint64(len(values) + 1)) // ord+1 indexes the end offset of the last name
+	return res
+}
+
+// uintType returns the smallest unsigned integer type holding n
+func uintType(n uint64) string {
+	switch {
+	case n <= math.MaxUint8:
+		return "uint8"
+	case n <= math.MaxUint16:
+		return "uint16"
+	case n <= math.MaxUint32:
+		return "uint32"
+	}
+	return "uint64"
+}
+
+// valueBounds returns public names of the values with the smallest and the largest numbers,
+// the first declared one if several values share the number
+func valueBounds(values []Value) (minName, maxName string) {
+	lo, hi := values[0], values[0]
+	for _, v := range values[1:] {
+		if v.Index < lo.Index {
+			lo = v
+		}
+		if v.Index > hi.Index {
+			hi = v
+		}
+	}
+	return lo.PublicName, hi.PublicName
+}
+
+// splitConstraints validates build constraints set with SetSplitTag and returns them by feature,
+// in the canonical form of the "//go:build" line
+func (g *Generator) splitConstraints() (map[string]string, error) {
+	if len(g.splitTags) == 0 {
+		return nil, nil
+	}
+	if !g.splitFiles {
+		return nil, fmt.Errorf("split-tag of type %s requires split", g.Type)
+	}
+	res := make(map[string]string, len(g.splitTags))
+	for _, feature := range slices.Sorted(maps.Keys(g.splitTags)) {
+		if !slices.Contains([]string{"sql", "bson", "yaml", "toml", "pgx"}, feature) {
+			return nil, fmt.Errorf("unknown split-tag feature %q, expected sql, bson, yaml, toml or pgx", feature)
+		}
+		expr, err := constraint.Parse("//go:build " + g.splitTags[feature])
+		if err != nil {
+			return nil, fmt.Errorf("invalid split-tag constraint %q of feature %s: %w", g.splitTags[feature], feature, err)
+		}
+		res[feature] = expr.String()
+	}
+	return res, nil
+}
+
+// getFeatureFileName returns the file name for an optional feature in split mode,
+// e.g. "status_enum_sql.go" for "status_enum.go" and "sql"
+func getFeatureFileName(fileName, feature string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_" + feature + ".go"
+}
+
+// getTestHelpersFileName returns the test helpers file name for the generated file, e.g. "status_enum_helpers_test.go".
+// It differs from status_enum_test.go, a common name for hand-written tests of the generated code.
+func getTestHelpersFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_helpers_test.go"
+}
+
+// getTestsFileName returns the generated tests file name for the generated file, e.g. "status_enum_test.go"
+func getTestsFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_test.go"
+}
+
+// getExamplesFileName returns the examples file name for the generated file, e.g. "status_enum_example_test.go"
+func getExamplesFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_example_test.go"
+}
+
+// removeGeneratedFile removes the file if it exists and was created by the generator, and reports
+// whether it was removed. Files without the generated code header are left intact.
+func removeGeneratedFile(path string) (bool, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is built from the output dir and type name
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !isGeneratedFile(data) {
+		return false, nil
+	}
+	return true, os.Remove(path)
+}
+
+// validateAliases checks for duplicate aliases and conflicts with canonical names
+func (g *Generator) validateAliases() error {
+	// collect all canonical names first (case-insensitive)
+	canonicalNames := make(map[string]string) // lowercase -> constant name
+	for name, cv := range g.values {
+		canonical := g.caseName(titleCaser.String(strings.TrimPrefix(g.trimName(name), g.Type)))
+		if g.stringBacked() {
+			canonical = cv.str
+		}
+		if cv.name != "" {
+			canonical = cv.name
+		}
+		canonicalNames[strings.ToLower(canonical)] = name
+	}
+
+	// validate aliases
+	aliasToConst := make(map[string]string) // lowercase alias -> constant name
+	var errs []error
+
+	for _, name := range sortedKeys(g.values) {
+		for _, alias := range g.values[name].aliases {
+			lowerAlias := strings.ToLower(alias)
+
+			// check if alias conflicts with a DIFFERENT constant's canonical name
+			if existingName, ok := canonicalNames[lowerAlias]; ok && existingName != name {
+				errs = append(errs, fmt.Errorf("alias %q for %s conflicts with canonical name of %s", alias, name, existingName))
+				continue
+			}
+
+			// check for duplicate aliases
+			if existingName, ok := aliasToConst[lowerAlias]; ok {
+				errs = append(errs, &DuplicateNameError{Type: g.Type, Kind: "alias", Name: alias, Consts: [2]string{existingName, name}})
+				continue
+			}
+
+			aliasToConst[lowerAlias] = name
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// parseAliasComment extracts aliases from an inline comment like "// enum:alias=rw,read-write"
+func parseAliasComment(comment *ast.CommentGroup) []string {
+	if comment == nil {
+		return nil
+	}
+	for _, c := range comment.List {
+		text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		if strings.HasPrefix(text, "enum:alias=") {
+			aliasStr := strings.TrimPrefix(text, "enum:alias=")
+			aliasStr, _, _ = strings.Cut(aliasStr, " enum:") // other directives on the same line
+			if aliasStr == "" {
+				return nil
+			}
+			aliases := strings.Split(aliasStr, ",")
+			result := make([]string, 0, len(aliases))
+			for _, a := range aliases {
+				if trimmed := strings.TrimSpace(a); trimmed != "" {
+					result = append(result, trimmed)
+				}
+			}
+			if len(result) == 0 {
+				return nil
+			}
+			return result
+		}
+	}
+	return nil
+}
+
+// parseTextDirective extracts free text of a directive like "// enum:desc=Can log in and post" from the first
+// comment group containing it, and reports whether the directive is present. The text runs to the end of the line
+// or to the next enum: directive, "=" and the text are optional, e.g. "// enum:deprecated".
+func parseTextDirective(name string, comments ...*ast.CommentGroup) (string, bool) {
+	directive := "enum:" + name
+	for _, comment := range comments {
+		if comment == nil {
+			continue
+		}
+		for _, c := range comment.List {
+			for _, field := range strings.Fields(strings.TrimPrefix(c.Text, "//")) {
+				if field != directive && !strings.HasPrefix(field, directive+"=") {
+					continue
+				}
+				_, text, _ := strings.Cut(c.Text, field)
+				text = field[len(directive):] + text
+				text, _, _ = strings.Cut(text, " enum:") // other directives on the same line
+				return strings.TrimSpace(strings.TrimPrefix(text, "=")), true
+			}
+		}
+	}
+	return "", false
+}
+
+// parseDirectiveValue returns the value of "enum:<name>=<value>" directive from the first comment group
+// containing it. Several directives can share a line, e.g. "// enum:since=v2 enum:until=v3".
+func parseDirectiveValue(name string, comments ...*ast.CommentGroup) string {
+	prefix := "enum:" + name + "="
+	for _, comment := range comments {
+		if comment == nil {
+			continue
+		}
+		for _, c := range comment.List {
+			for _, field := range strings.Fields(strings.TrimPrefix(c.Text, "//")) {
+				if strings.HasPrefix(field, prefix) {
+					return strings.TrimPrefix(field, prefix)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// validateVersions checks enum:since and enum:until values, versions must be numeric like "v2" or "v2.1"
+// and since can't be after until
+func validateVersions(values []Value) error {
+	var errs []error
+	for _, v := range values {
+		for _, ver := range []string{v.Since, v.Until} {
+			if ver != "" && !isValidVersion(ver) {
+				errs = append(errs, fmt.Errorf("invalid version %q for %s, expected format like v2 or v2.1", ver, v.PrivateName))
+			}
+		}
+		if isValidVersion(v.Since) && isValidVersion(v.Until) && compareVersions(v.Since, v.Until) > 0 {
+			errs = append(errs, fmt.Errorf("version range of %s is empty: since %s is after until %s", v.PrivateName, v.Since, v.Until))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validatePublicNames checks exported constant names, names pinned with enum:public= must be exported
+// identifiers, unique and different from the generated type name
+func (g *Generator) validatePublicNames(values []Value) error {
+	var errs []error
+	seen := make(map[string]string, len(values))
+	for _, v := range values {
+		if !isValidGoIdentifier(v.PublicName) || !token.IsExported(v.PublicName) {
+			errs = append(errs, fmt.Errorf("invalid public name %q for %s, expected exported identifier", v.PublicName, v.PrivateName))
+			continue
+		}
+		if v.PublicName == g.exportedName(g.Type) {
+			errs = append(errs, fmt.Errorf("public name %s of %s conflicts with the type name", v.PublicName, v.PrivateName))
+			continue
+		}
+		if other, ok := seen[v.PublicName]; ok {
+			errs = append(errs, fmt.Errorf("duplicate public name %s: used by both %s and %s", v.PublicName, other, v.PrivateName))
+			continue
+		}
+		seen[v.PublicName] = v.PrivateName
+	}
+	return errors.Join(errs...)
+}
+
+// checkVersion checks that the running generator is not older than the required version
+func (g *Generator) checkVersion() error {
+	if g.requireVersion == "" {
+		return nil
+	}
+	required := g.requireVersion
+	if !strings.HasPrefix(required, "v") {
+		required = "v" + required
+	}
+	if !semver.IsValid(required) {
+		return fmt.Errorf("invalid required version %q, expected format like v1.3.0", g.requireVersion)
+	}
+	if !semver.IsValid(g.version) {
+		return nil // development build, version unknown
+	}
+	if module.IsPseudoVersion(g.version) {
+		if base, err := module.PseudoVersionBase(g.version); err != nil || base == "" {
+			return nil // built from a commit without a tagged ancestor, version unknown
+		}
+	}
+	// pre-releases are older than the release, e.g. v1.4.0-rc.1 doesn't satisfy v1.4.0
+	if semver.Compare(g.version, required) < 0 {
+		return fmt.Errorf("enum generator %s is older than required %s, run \"enum self-update\" or "+
+			"go install github.com/go-pkgz/enum@%s", g.version, g.requireVersion, required)
+	}
+	return nil
+}
+
+// generatedMethods are methods of the enum type generated by the template, predicate methods can't use them
+var generatedMethods = []string{"AvailableIn", "Deprecated", "Description", "Equal", "EqualString", "Format",
+	"Generate", "GoString", "Index", "Localized", "MarshalBSONValue", "MarshalBinary", "MarshalGQL", "MarshalJSON",
+	"MarshalTOML", "MarshalText", "MarshalYAML", "Next", "Prev", "Ptr", "RedisArg", "RedisScan", "Scan", "ScanText",
+	"Set", "String", "TextValue", "Type", "UnmarshalBSONValue", "UnmarshalBinary", "UnmarshalGQL", "UnmarshalJSON",
+	"UnmarshalTOML", "UnmarshalText", "UnmarshalYAML", "Value", "Values"}
+
+// buildMethods checks predicate methods and resolves their values to public names
+func (g *Generator) buildMethods(values []Value) ([]Method, error) {
+	var errs []error
+	res := make([]Method, 0, len(g.methods))
+	seen := make(map[string]bool, len(g.methods))
+	for _, m := range g.methods {
+		switch {
+		case !isValidGoIdentifier(m.Name) || !token.IsExported(m.Name):
+			errs = append(errs, fmt.Errorf("invalid method name %q, expected exported identifier", m.Name))
+			continue
+		case slices.Contains(generatedMethods, m.Name):
+			errs = append(errs, fmt.Errorf("method %s conflicts with a generated method of type %s", m.Name, g.Type))
+			continue
+		case seen[m.Name]:
+			errs = append(errs, fmt.Errorf("duplicate method %s", m.Name))
+			continue
+		}
+		seen[m.Name] = true
+
+		method := Method{Name: m.Name}
+		for _, name := range m.Values {
+			idx := slices.IndexFunc(values, func(v Value) bool {
+				return v.PrivateName == g.privateValueName(name) || v.PublicName == name
+			})
+			if idx < 0 {
+				errs = append(errs, fmt.Errorf("method %s: unknown value %s of type %s", m.Name, name, g.Type))
+				continue
+			}
+			if !slices.Contains(method.Values, values[idx].PublicName) {
+				method.Values = append(method.Values, values[idx].PublicName)
+			}
+		}
+		res = append(res, method)
+	}
+	return res, errors.Join(errs...)
+}
+
+// validateOrder checks enum:order= values of const blocks, the order must be an integer
+func (g *Generator) validateOrder(values []Value) error {
+	var errs []error
+	reported := make(map[string]bool)
+	for _, v := range values {
+		cv := g.values[v.PrivateName]
+		if cv == nil || cv.order == "" || reported[cv.order] {
+			continue
+		}
+		if _, err := strconv.Atoi(cv.order); err != nil {
+			errs = append(errs, fmt.Errorf("invalid order %q of the const block with %s, expected integer", cv.order, v.PrivateName))
+			reported[cv.order] = true
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateNames checks names used for marshaling and parsing. Each value needs a name left after stripping
+// the type prefix, names must be unique case-insensitively as parsing ignores case, and with numeric
+// unmarshaling a name can't look like a number.
+func (g *Generator) validateNames(values []Value) error {
+	var errs []error
+	seen := make(map[string]string, len(values))
+	for _, v := range values {
+		if v.Name == "" && !g.stringBacked() {
+			errs = append(errs, fmt.Errorf("constant %s has no name after the type prefix %s, rename it, e.g. to %sUnknown",
+				v.PrivateName, g.Type, g.Type))
+			continue
+		}
+		if other, ok := seen[strings.ToLower(v.Name)]; ok {
+			errs = append(errs, &DuplicateNameError{Type: g.Type, Kind: "name", Name: v.Name, Consts: [2]string{other, v.PrivateName}})
+			continue
+		}
+		seen[strings.ToLower(v.Name)] = v.PrivateName
+		if _, err := strconv.ParseInt(v.Name, 10, 64); err == nil && (g.marshalNumber || g.tolerant) {
+			errs = append(errs, fmt.Errorf("name %q of %s is a number, it can't be told apart from numeric values", v.Name, v.PrivateName))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isValidVersion reports whether the version is a dot-separated list of numbers with an optional "v" prefix
+func isValidVersion(ver string) bool {
+	if ver == "" {
+		return false
+	}
+	for _, part := range strings.Split(strings.TrimPrefix(ver, "v"), ".") {
+		if part == "" {
+			return false
+		}
+		for _, c := range part {
+			if c < '0' || c > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// compareVersions compares versions numerically, part by part, missing parts are zero. Returns -1, 0 or 1.
+// The generated code contains the same logic to compare versions at runtime.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(strings.TrimPrefix(a, "v"), "."), strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// hasVersions reports whether any value has enum:since or enum:until annotation
+func hasVersions(values []Value) bool {
+	for _, v := range values {
+		if v.Since != "" || v.Until != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDocComment extracts free-text documentation from a comment group,
+// skipping any lines that are enum: directives (e.g., enum:alias=...).
+// Multiple non-directive lines are joined with a single space.
+func parseDocComment(comment *ast.CommentGroup) string {
+	if comment == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(comment.List))
+	for _, c := range comment.List {
+		text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		if text == "" || strings.HasPrefix(text, "enum:") {
+			continue
+		}
+		parts = append(parts, text)
+	}
+	return strings.Join(parts, " ")
+}
+
+// isValidGoIdentifier checks if a string is a valid Go identifier:
+// - must start with a letter or underscore
+// - can contain letters, digits, and underscores
+func isValidGoIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for i, c := range s {
+		if i == 0 {
+			if !unicode.IsLetter(c) && c != '_' {
+				return false
+			}
+		} else {
+			if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// templateHooks are the extension points of the embedded template which can be filled with SetTemplateExtras
+var templateHooks = []string{"extraImports", "extraMethods"}
+
+// loadTemplate returns the template set to execute and the name of the main template in it, empty for the
+// root template. Custom templates are parsed into a clone of the embedded one, so {{define}} blocks override
+// the embedded templates with the same name. A template file becomes the main template, for a directory
+// it is enum.go.tmpl if present there, otherwise the embedded one is kept. Snippets set with
+// SetTemplateExtras fill the extension hooks last.
+func (g *Generator) loadTemplate() (*template.Template, string, error) {
+	if g.templatePath == "" && len(g.templateExtras) == 0 {
+		return enumTemplate, "", nil
+	}
+
+	tmpl, err := enumTemplate.Clone()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to load template: %w", err)
+	}
+	mainTemplate := ""
+	if g.templatePath != "" {
+		if mainTemplate, err = g.parseCustomTemplate(tmpl); err != nil {
+			return nil, "", err
+		}
+	}
+
+	for _, hook := range sortedKeys(g.templateExtras) {
+		if !slices.Contains(templateHooks, hook) {
+			return nil, "", fmt.Errorf("unknown template hook %q, expected one of: %s", hook, strings.Join(templateHooks, ", "))
+		}
+		file := g.templateExtras[hook]
+		content, err := os.ReadFile(file) //nolint:gosec // snippet path is provided by the user
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to load template snippet: %w", err)
+		}
+		if _, err := tmpl.New(hook).Parse(string(content)); err != nil {
+			return nil, "", fmt.Errorf("failed to parse template snippet %s: %w", file, err)
+		}
+	}
+	return tmpl, mainTemplate, nil
+}
+
+// parseCustomTemplate parses the custom template file or directory into tmpl and returns the name of
+// the main template, empty if the directory doesn't replace it
+func (g *Generator) parseCustomTemplate(tmpl *template.Template) (string, error) {
+	info, err := os.Stat(g.templatePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to load template: %w", err)
+	}
+	files := []string{g.templatePath}
+	if info.IsDir() {
+		if files, err = filepath.Glob(filepath.Join(g.templatePath, "*.tmpl")); err != nil {
+			return "", fmt.Errorf("failed to load template: %w", err)
+		}
+		if len(files) == 0 {
+			return "", fmt.Errorf("no *.tmpl files in template directory %s", g.templatePath)
+		}
+	}
+
+	mainTemplate := ""
+	for _, file := range files {
+		content, err := os.ReadFile(file) //nolint:gosec // template path is provided by the user
+		if err != nil {
+			return "", fmt.Errorf("failed to load template: %w", err)
+		}
+		name := filepath.Base(file)
+		if _, err := tmpl.New(name).Parse(string(content)); err != nil {
+			return "", fmt.Errorf("failed to parse template %s: %w", file, err)
+		}
+		if !info.IsDir() || name == "enum.go.tmpl" {
+			mainTemplate = name
+		}
+	}
+	return mainTemplate, nil
+}
+
+// generatedComment marks generated files, generatedHeader is the first line of every generated Go file
+const (
+	generatedComment = "Code generated by enum generator; DO NOT EDIT."
+	generatedHeader  = "// " + generatedComment
+)
+
+var funcMap = template.FuncMap{
+	"title":       titleCaser.String,
+	"ToLower":     strings.ToLower,
+	"jsonLiteral": jsonLiteral,
+	"goString":    goStringLiteral,
+}
+
+// jsonLiteral returns a Go string literal of the JSON encoding of s, a raw string if possible
+func jsonLiteral(s string) (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return goStringLiteral(string(b)), nil
+}
+
+// goStringLiteral returns a Go string literal of s, a raw string if possible to keep documents readable
+func goStringLiteral(s string) string {
+	if strings.ContainsAny(s, "`\r") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+// writtenByGenerator reports whether the parsed Go file was written by the generator, with the generated
+// code comment before the package clause
+func writtenByGenerator(file *ast.File) bool {
+	for _, cg := range file.Comments {
+		if cg.Pos() > file.Package {
+			return false
+		}
+		for _, c := range cg.List {
+			if c.Text == generatedHeader {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isGeneratedFile reports whether the content was written by the generator, a Go file or a schema.
+// Go files may start with a header of SetHeader, followed by the generated code comment.
+func isGeneratedFile(data []byte) bool {
+	for _, header := range []string{generatedHeader, schemaHeader, openAPIHeader, sqlHeader} {
+		if bytes.HasPrefix(data, []byte(header)) {
+			return true
+		}
+	}
+	for line := range bytes.Lines(data) {
+		line = bytes.TrimSpace(line)
+		if string(line) == generatedHeader {
+			return true
+		}
+		if len(line) > 0 && !bytes.HasPrefix(line, []byte("//")) {
+			return false
+		}
+	}
+	return false
+}
+
+//go:embed enum.go.tmpl
+var tmplt string
+
+// template for the generated enum code, creates:
+// - exported type with name and value fields
+// - String method for fmt.Stringer
+// - Marshal/Unmarshal for JSON support
+// - Parse function with error handling
+// - Must variant that panics on error
+// - exported const values
+// - Values and Names helper functions
+var enumTemplate = template.Must(template.New("enum").Funcs(funcMap).Parse(tmplt))
@@ -0,0 +1,4610 @@
+package generator
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator(t *testing.T) {
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := New("", "")
+		require.Error(t, err, "empty type name should fail")
+
+		_, err = New("Status", "")
+		require.Error(t, err, "uppercase type name should fail")
+
+		_, err = New("type", "")
+		require.EqualError(t, err, `invalid type name "type", must be a Go identifier and not a keyword`)
+
+		_, err = New("job-status", "")
+		require.EqualError(t, err, `invalid type name "job-status", must be a Go identifier and not a keyword`)
+
+		gen, err := New("status", "")
+		require.NoError(t, err)
+		assert.NotNil(t, gen)
+
+		gen, err = New("moreComplexType", "")
+		require.NoError(t, err)
+		assert.NotNil(t, gen)
+
+		// check if generated code is valid Go code
+		tmpDir := t.TempDir()
+		gen, err = New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// enable SQL to verify SQL-specific imports/methods when requested
+		gen.SetGenerateSQL(true)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// try to parse generated code
+		fset := token.NewFileSet()
+		genFile := filepath.Join(tmpDir, "status_enum.go")
+		_, err = parser.ParseFile(fset, genFile, nil, parser.AllErrors)
+		require.NoError(t, err, "generated code should be valid Go code")
+
+		// validate default values correctness
+		content, err := os.ReadFile(genFile)
+		require.NoError(t, err)
+
+		// check required imports
+		assert.Contains(t, string(content), `"database/sql/driver"`)
+		assert.Contains(t, string(content), `"fmt"`)
+
+		// check required type definition
+		assert.Contains(t, string(content), "type Status struct {")
+		assert.Contains(t, string(content), "name  string")
+		assert.Contains(t, string(content), "value int")
+
+		// check all required methods are present
+		methods := []string{
+			"String() string",
+			"MarshalText() ([]byte, error)",
+			"UnmarshalText(text []byte) error",
+			"Value() (driver.Value, error)",
+			"Scan(value interface{}) error",
+			"ParseStatus(v string) (Status, error)",
+			"MustStatus(v string) Status",
+			"var StatusValues = []Status",
+			"var StatusNames = []string",
+		}
+		for _, method := range methods {
+			assert.Contains(t, string(content), method, "method %s should be present", method)
+		}
+	})
+
+	t.Run("parse and generate", func(t *testing.T) {
+		// create temp dir for output
+		tmpDir := t.TempDir()
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		// parse testdata
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// generate
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// verify file was created
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// check content
+		assert.Contains(t, string(content), "type Status struct")
+		assert.Contains(t, string(content), "StatusActive")
+		assert.Contains(t, string(content), "StatusInactive")
+		assert.Contains(t, string(content), "StatusBlocked")
+	})
+
+	t.Run("parse and generate with complex name", func(t *testing.T) {
+		// create temp dir for output
+		tmpDir := t.TempDir()
+
+		gen, err := New("jobStatus", tmpDir)
+		require.NoError(t, err)
+
+		// parse testdata
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// generate
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// verify file was created
+		content, err := os.ReadFile(filepath.Join(tmpDir, "job_status_enum.go"))
+		require.NoError(t, err)
+
+		// check content
+		assert.Contains(t, string(content), "type JobStatus struct")
+		assert.Contains(t, string(content), "JobStatusActive")
+		assert.Contains(t, string(content), "JobStatusInactive")
+		assert.Contains(t, string(content), "JobStatusBlocked")
+	})
+
+	t.Run("sql support", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		gen.SetGenerateSQL(true)
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// verify sql interface implementations are present
+		assert.Contains(t, string(content), "func (e Status) Value() (driver.Value, error)")
+		assert.Contains(t, string(content), "func (e *Status) Scan(value interface{}) error")
+
+		// verify sql imports
+		assert.Contains(t, string(content), `"database/sql/driver"`)
+
+		// verify nil handling
+		assert.Contains(t, string(content), "if value == nil {")
+		assert.Contains(t, string(content), "if v.Index() == 0")
+
+		// verify []byte support
+		assert.Contains(t, string(content), "if b, ok := value.([]byte)")
+	})
+
+	t.Run("bson support", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		gen.SetGenerateBSON(true)
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// verify bson interfaces and imports
+		assert.Contains(t, string(content), `"go.mongodb.org/mongo-driver/bson"`)
+		assert.Contains(t, string(content), `"go.mongodb.org/mongo-driver/bson/bsontype"`)
+		assert.Contains(t, string(content), "func (e Status) MarshalBSONValue() (bsontype.Type, []byte, error)")
+		assert.Contains(t, string(content), "func (e *Status) UnmarshalBSONValue(t bsontype.Type, data []byte) error")
+	})
+
+	t.Run("yaml support", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		gen.SetGenerateYAML(true)
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// verify yaml interfaces and imports
+		assert.Contains(t, string(content), `"gopkg.in/yaml.v3"`)
+		assert.Contains(t, string(content), "func (e Status) MarshalYAML() (any, error)")
+		assert.Contains(t, string(content), "func (e *Status) UnmarshalYAML(value *yaml.Node) error")
+	})
+
+	t.Run("toml support", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+
+		gen.SetGenerateTOML(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"github.com/pelletier/go-toml/v2/unstable"`)
+		assert.Contains(t, string(content), `"strconv"`)
+		assert.Contains(t, string(content), "func (e Status) MarshalTOML() ([]byte, error) {\n\treturn strconv.AppendQuote(nil, e.String()), nil")
+		assert.Contains(t, string(content), "func (e *Status) UnmarshalTOML(value *unstable.Node) error {")
+		assert.Contains(t, string(content), "if value == nil || value.Kind != unstable.String {")
+
+		// split into a separate file
+		gen.SetSplitFiles(true)
+		require.NoError(t, gen.Generate())
+		content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "go-toml")
+		assert.NotContains(t, string(content), `"strconv"`)
+		tomlFile, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_toml.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(tomlFile), `"github.com/pelletier/go-toml/v2/unstable"`)
+		assert.Contains(t, string(tomlFile), "func (e *Status) UnmarshalTOML(value *unstable.Node) error {")
+	})
+
+	t.Run("json support", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// verify text marshaling interface implementations are present (used by json)
+		assert.Contains(t, string(content), "func (e Status) MarshalText() ([]byte, error)")
+		assert.Contains(t, string(content), "func (e *Status) UnmarshalText(text []byte) error")
+
+		// verify UnmarshalText uses Parse
+		assert.Contains(t, string(content), "ParseStatus(string(text))")
+
+		// verify string conversion in marshal
+		assert.Contains(t, string(content), "return []byte(e.name), nil")
+	})
+
+	t.Run("missing type", func(t *testing.T) {
+		gen, err := New("nonexistent", "")
+		require.NoError(t, err)
+
+		err = gen.Parse("../testdata")
+		assert.Error(t, err)
+	})
+
+	t.Run("explicit values", func(t *testing.T) {
+		// create temp dir for output
+		tmpDir := t.TempDir()
+
+		gen, err := New("explicitValues", tmpDir)
+		require.NoError(t, err)
+
+		// parse testdata
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// generate
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// verify file was created
+		content, err := os.ReadFile(filepath.Join(tmpDir, "explicit_values_enum.go"))
+		require.NoError(t, err)
+
+		// check content
+		assert.Contains(t, string(content), "type ExplicitValues struct")
+		assert.Contains(t, string(content), "value: 10") // should have actual value 10, not 0
+		assert.Contains(t, string(content), "value: 20") // should have actual value 20, not 1
+		assert.Contains(t, string(content), "value: 30") // should have actual value 30, not 2
+	})
+
+	t.Run("generate getter", func(t *testing.T) {
+		// create temp dir for output
+		tmpDir := t.TempDir()
+
+		gen, err := New("jobStatus", tmpDir)
+		require.NoError(t, err)
+		gen.SetGenerateGetter(true)
+
+		// parse testdata
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// generate
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// verify file was created
+		content, err := os.ReadFile(filepath.Join(tmpDir, "job_status_enum.go"))
+		require.NoError(t, err)
+
+		// check content
+		assert.Contains(t, string(content), "func GetJobStatusByID(v uint8) (JobStatus, error)")
+		assert.Contains(t, string(content), "case 0:\n\t\treturn JobStatusUnknown, nil")
+		assert.Contains(t, string(content), "case 1:\n\t\treturn JobStatusActive, nil")
+		assert.Contains(t, string(content), "case 2:\n\t\treturn JobStatusInactive, nil")
+		assert.Contains(t, string(content), "case 3:\n\t\treturn JobStatusBlocked, nil")
+	})
+
+	t.Run("generate getter explicit values", func(t *testing.T) {
+		// create temp dir for output
+		tmpDir := t.TempDir()
+
+		gen, err := New("explicitValues", tmpDir)
+		require.NoError(t, err)
+		gen.SetGenerateGetter(true)
+
+		// parse testdata
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// generate
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// verify file was created
+		content, err := os.ReadFile(filepath.Join(tmpDir, "explicit_values_enum.go"))
+		require.NoError(t, err)
+
+		// check content
+		assert.Contains(t, string(content), "func GetExplicitValuesByID(v uint8) (ExplicitValues, error)")
+		assert.Contains(t, string(content), "case 10:\n\t\treturn ExplicitValuesFirst, nil")
+		assert.Contains(t, string(content), "case 20:\n\t\treturn ExplicitValuesSecond, nil")
+		assert.Contains(t, string(content), "case 30:\n\t\treturn ExplicitValuesThird, nil")
+	})
+
+	t.Run("generate getter repeated values", func(t *testing.T) {
+		// create temp dir for output
+		tmpDir := t.TempDir()
+
+		gen, err := New("repeatValues", tmpDir)
+		require.NoError(t, err)
+		gen.SetGenerateGetter(true)
+
+		// parse testdata
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// generate
+		err = gen.Generate()
+		require.Error(t, err, "should fail with repeated values")
+		assert.Contains(t, err.Error(), "multiple names for value 10: ")
+		assert.Contains(t, err.Error(), "multiple names for value 20: ")
+	})
+
+	t.Run("invalid package", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		err := os.WriteFile(filepath.Join(tmpDir, "invalid.go"), []byte(`invalid go file`), 0o600)
+		require.NoError(t, err)
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse(tmpDir)
+		assert.Error(t, err)
+	})
+
+	t.Run("non-existent directory", func(t *testing.T) {
+		gen, err := New("status", "")
+		require.NoError(t, err)
+
+		err = gen.Parse("non-existent-dir")
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid output directory", func(t *testing.T) {
+		gen, err := New("status", "/non-existent-dir")
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		assert.Error(t, err)
+	})
+}
+
+func TestGeneratorValues(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Parse("testdata")
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, gen.values["statusUnknown"].value, "unknown should be 0")
+	assert.Equal(t, 1, gen.values["statusActive"].value, "active should be 1")
+	assert.Equal(t, 2, gen.values["statusInactive"].value, "inactive should be 2")
+	assert.Equal(t, 3, gen.values["statusBlocked"].value, "blocked should be 3")
+}
+
+func TestRepeatValues(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	gen, err := New("repeatValues", tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Parse("testdata")
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, gen.values["repeatValuesFirst"].value, "First should be 10")
+	assert.Equal(t, 10, gen.values["repeatValuesSecond"].value, "Second should repeat the value 10")
+	assert.Equal(t, 20, gen.values["repeatValuesThird"].value, "Third should be 20")
+	assert.Equal(t, 20, gen.values["repeatValuesFourth"].value, "Fourth should repeat the value 20")
+}
+
+func TestSQLNullHandling(t *testing.T) {
+	t.Run("with zero value", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		gen.SetGenerateSQL(true)
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// should scan nil to zero value when it exists
+		assert.Contains(t, string(content), "if v.Index() == 0")
+		assert.Contains(t, string(content), "*e = v")
+		assert.Contains(t, string(content), "return nil")
+	})
+
+	t.Run("without zero value", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("noZero", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		gen.SetGenerateSQL(true)
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "no_zero_enum.go"))
+		require.NoError(t, err)
+
+		// should return error when no zero value exists
+		assert.Contains(t, string(content), "cannot scan nil into NoZero: no zero value defined")
+	})
+}
+
+func TestDeclarationOrderPreservation(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	gen, err := New("orderTest", tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Parse("testdata")
+	require.NoError(t, err)
+
+	// generate the enum
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	// read the generated file
+	content, err := os.ReadFile(filepath.Join(tmpDir, "order_test_enum.go"))
+	require.NoError(t, err)
+
+	// check that values appear in declaration order in Values() function
+	// the order should be Zero, Alpha, Charlie, Bravo (not alphabetical)
+	contentStr := string(content)
+
+	// find the Values var and check order
+	valuesIdx := strings.Index(contentStr, "var OrderTestValues = []OrderTest")
+	require.GreaterOrEqual(t, valuesIdx, 0, "Should find OrderTestValues var")
+	valuesSection := contentStr[valuesIdx : valuesIdx+300]
+
+	// check order - Zero should come before Alpha, Alpha before Charlie, Charlie before Bravo
+	zeroIdx := strings.Index(valuesSection, "OrderTestZero")
+	alphaIdx := strings.Index(valuesSection, "OrderTestAlpha")
+	charlieIdx := strings.Index(valuesSection, "OrderTestCharlie")
+	bravoIdx := strings.Index(valuesSection, "OrderTestBravo")
+
+	assert.Less(t, zeroIdx, alphaIdx, "Zero should come before Alpha")
+	assert.Less(t, alphaIdx, charlieIdx, "Alpha should come before Charlie")
+	assert.Less(t, charlieIdx, bravoIdx, "Charlie should come before Bravo (not alphabetical)")
+
+	// find the Names var and check order
+	namesIdx := strings.Index(contentStr, "var OrderTestNames = []string")
+	require.GreaterOrEqual(t, namesIdx, 0, "Should find OrderTestNames var")
+	namesSection := contentStr[namesIdx : namesIdx+200]
+
+	// check order in names
+	zeroNameIdx := strings.Index(namesSection, `"Zero"`)
+	alphaNameIdx := strings.Index(namesSection, `"Alpha"`)
+	charlieNameIdx := strings.Index(namesSection, `"Charlie"`)
+	bravoNameIdx := strings.Index(namesSection, `"Bravo"`)
+
+	assert.Less(t, zeroNameIdx, alphaNameIdx, "Zero name should come before Alpha")
+	assert.Less(t, alphaNameIdx, charlieNameIdx, "Alpha name should come before Charlie")
+	assert.Less(t, charlieNameIdx, bravoNameIdx, "Charlie name should come before Bravo (not alphabetical)")
+}
+
+func TestBinaryExprValues(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	gen, err := New("binaryExpr", tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Parse("testdata")
+	require.NoError(t, err)
+
+	// check that all values are found
+	assert.Contains(t, gen.values, "binaryExprFirst", "First value should be found")
+	assert.Contains(t, gen.values, "binaryExprSecond", "Second value should be found")
+	assert.Contains(t, gen.values, "binaryExprThird", "Third value should be found")
+
+	// check that values are correct (iota + 1)
+	assert.Equal(t, 1, gen.values["binaryExprFirst"].value, "First should be 1")
+	assert.Equal(t, 2, gen.values["binaryExprSecond"].value, "Second should be 2")
+	assert.Equal(t, 3, gen.values["binaryExprThird"].value, "Third should be 3")
+
+	// generate the enum and verify it contains all constants
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	// verify file was created
+	content, err := os.ReadFile(filepath.Join(tmpDir, "binary_expr_enum.go"))
+	require.NoError(t, err)
+
+	// check that all constants are present in the generated file
+	assert.Contains(t, string(content), "BinaryExprFirst")
+	assert.Contains(t, string(content), "BinaryExprSecond")
+	assert.Contains(t, string(content), "BinaryExprThird")
+
+	// check the values are correct
+	assert.Contains(t, string(content), "value: 1")
+	assert.Contains(t, string(content), "value: 2")
+	assert.Contains(t, string(content), "value: 3")
+}
+
+func TestGeneratorSubdir(t *testing.T) {
+	tmpDir := t.TempDir()
+	subDir := filepath.Join(tmpDir, "subpkg")
+	require.NoError(t, os.MkdirAll(subDir, 0o700))
+
+	gen, err := New("status", subDir)
+	require.NoError(t, err)
+
+	err = gen.Parse("testdata")
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	// verify file was created with correct package
+	content, err := os.ReadFile(filepath.Join(subDir, "status_enum.go"))
+	require.NoError(t, err)
+
+	// should be package subpkg, not testdata
+	assert.Contains(t, string(content), "package subpkg")
+}
+
+func TestGeneratorLowerCase(t *testing.T) {
+	t.Run("lower case values", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		subDir := filepath.Join(tmpDir, "testenum")
+		require.NoError(t, os.MkdirAll(subDir, 0o700))
+
+		gen, err := New("status", subDir)
+		require.NoError(t, err)
+		gen.SetLowerCase(true)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(subDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// check string values are lowercase
+		assert.Contains(t, string(content), `name: "active"`)
+		assert.Contains(t, string(content), `name: "blocked"`)
+		assert.Contains(t, string(content), `name: "inactive"`)
+		assert.Contains(t, string(content), `name: "unknown"`)
+
+		// check parse map has lowercase keys
+		assert.Contains(t, string(content), `"active":   StatusActive`)
+		// parsing is always case-insensitive, so strings.ToLower is always used
+		parseIdx := bytes.Index(content, []byte("func ParseStatus"))
+		parseEnd := bytes.Index(content[parseIdx:], []byte("}"))
+		parseFunc := string(content[parseIdx : parseIdx+parseEnd])
+		assert.Contains(t, parseFunc, "strings.ToLower")
+	})
+
+	t.Run("regular case values", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		subDir := filepath.Join(tmpDir, "testenum")
+		require.NoError(t, os.MkdirAll(subDir, 0o700))
+
+		gen, err := New("status", subDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(subDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// check string values are title case
+		assert.Contains(t, string(content), `name: "Active"`)
+		assert.Contains(t, string(content), `name: "Blocked"`)
+		assert.Contains(t, string(content), "strings.ToLower")
+	})
+}
+
+func TestPermissions(t *testing.T) {
+	t.Run("uses appropriate permissions", func(t *testing.T) {
+		// create source directory with custom permissions
+		tmpDir := t.TempDir()
+		sourceDir := filepath.Join(tmpDir, "source")
+		outputDir := filepath.Join(tmpDir, "output")
+
+		// create source directory with 0755 permissions
+		err := os.MkdirAll(sourceDir, 0o755)
+		require.NoError(t, err)
+
+		// create a sample status file
+		sampleFile := `package source
+type status int
+const (
+	statusUnknown status = iota
+	statusActive
+	statusInactive
+)
+`
+		err = os.WriteFile(filepath.Join(sourceDir, "status.go"), []byte(sampleFile), 0o644)
+		require.NoError(t, err)
+
+		// create generator and run it
+		gen, err := New("status", outputDir)
+		require.NoError(t, err)
+
+		err = gen.Parse(sourceDir)
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// check that output directory has same permissions as source directory
+		outputInfo, err := os.Stat(outputDir)
+		require.NoError(t, err)
+		// on some OS TempDir may return different permissions, so we just check it's not 0700
+		assert.NotEqual(t, os.FileMode(0o700), outputInfo.Mode().Perm(),
+			"Output directory shouldn't have hardcoded 0o700 permissions")
+
+		// check output file permissions
+		outputFile := filepath.Join(outputDir, "status_enum.go")
+		fileInfo, err := os.Stat(outputFile)
+		require.NoError(t, err)
+		// should be 0644 by default
+		assert.Equal(t, os.FileMode(0o644), fileInfo.Mode().Perm(),
+			"Output file should have 0o644 permissions")
+	})
+}
+
+func TestNoLinterWarningsForUnusedConstants(t *testing.T) {
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "linter_test.go"), []byte(`
+package test
+type linterTest uint8
+const (
+	linterTestUnknown linterTest = iota
+	linterTestValue1
+	linterTestValue2
+)
+`), 0o644)
+	require.NoError(t, err)
+
+	gen, err := New("linterTest", tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	// read the generated file to check for the linter warning prevention code
+	content, err := os.ReadFile(filepath.Join(tmpDir, "linter_test_enum.go"))
+	require.NoError(t, err)
+
+	// check that the unused constants prevention code exists
+	assert.Contains(t, string(content), "// These variables are used to prevent the compiler from reporting unused errors")
+	assert.Contains(t, string(content), "var _ = func() bool {")
+	assert.Contains(t, string(content), "var _ linterTest = linterTest(0)")
+	assert.Contains(t, string(content), "var _ linterTest = linterTestUnknown")
+	assert.Contains(t, string(content), "var _ linterTest = linterTestValue1")
+	assert.Contains(t, string(content), "var _ linterTest = linterTestValue2")
+	assert.Contains(t, string(content), "return true")
+}
+
+func TestGeneratorEdgeCases(t *testing.T) {
+	t.Run("invalid template", func(t *testing.T) {
+		// create a generator with a broken template that will fail to execute
+		gen, err := New("status", "")
+		require.NoError(t, err)
+
+		// override template with invalid one
+		origTmpl := enumTemplate
+		defer func() { enumTemplate = origTmpl }()
+		enumTemplate = template.Must(template.New("broken").Parse("{{.Unknown}}")) // will fail on execution
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to execute template")
+	})
+
+	t.Run("format error", func(t *testing.T) {
+		gen, err := New("status", "")
+		require.NoError(t, err)
+
+		// override template to generate invalid Go code
+		origTmpl := enumTemplate
+		defer func() { enumTemplate = origTmpl }()
+		enumTemplate = template.Must(template.New("invalid").Parse("invalid go code"))
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to format source")
+	})
+
+	t.Run("invalid identifier", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			input    string
+			expected bool
+		}{
+			{"empty", "", false},
+			{"starts with number", "123abc", false},
+			{"valid", "abc123", true},
+			{"valid with underscore", "abc_123", true},
+			{"starts with underscore", "_abc123", true},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.expected, isValidGoIdentifier(tc.input))
+			})
+		}
+	})
+}
+
+func TestParseSpecialCases(t *testing.T) {
+	t.Run("empty const block", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		err := os.WriteFile(filepath.Join(tmpDir, "empty.go"), []byte(`
+package test
+const (
+)
+`), 0o644)
+		require.NoError(t, err)
+
+		gen, err := New("status", "")
+		require.NoError(t, err)
+
+		err = gen.Parse(tmpDir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no const values found for type status")
+	})
+
+	t.Run("const without values", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		err := os.WriteFile(filepath.Join(tmpDir, "no_values.go"), []byte(`
+package test
+const name string
+`), 0o644)
+		require.NoError(t, err)
+
+		gen, err := New("status", "")
+		require.NoError(t, err)
+
+		err = gen.Parse(tmpDir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no const values found for type status")
+	})
+}
+
+func TestSplitCamelCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", []string{""}},
+		{"status", []string{"status"}},
+		{"internalIPAddress", []string{"internal", "IP", "Address"}},
+		{"internalIP", []string{"internal", "IP"}},
+		{"HTTP", []string{"HTTP"}},
+		{"HTTPResponseCode", []string{"HTTP", "Response", "Code"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result := splitCamelCase(test.input)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestGetFileNameForType(t *testing.T) {
+	tests := []struct {
+		typeName string
+		expected string
+	}{
+		{"status", "status_enum.go"},
+		{"jobStatus", "job_status_enum.go"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.typeName, func(t *testing.T) {
+			result := getFileNameForType(test.typeName)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestNegativeEnumValues(t *testing.T) {
+	t.Run("negative integers", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		// create enum with negative values
+		enumFile := filepath.Join(tmpDir, "test.go")
+		err := os.WriteFile(enumFile, []byte(`package test
+
+type errorCode int
+
+const (
+	errorCodeNone    errorCode = -1
+	errorCodeOK      errorCode = 0
+	errorCodeBadRequest errorCode = 400
+	errorCodeNotFound   errorCode = 404
+)`), 0o644)
+		require.NoError(t, err)
+
+		gen, err := New("errorCode", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse(tmpDir)
+		require.NoError(t, err)
+
+		// verify negative value was parsed correctly
+		assert.Equal(t, -1, gen.values["errorCodeNone"].value)
+		assert.Equal(t, 0, gen.values["errorCodeOK"].value)
+		assert.Equal(t, 400, gen.values["errorCodeBadRequest"].value)
+		assert.Equal(t, 404, gen.values["errorCodeNotFound"].value)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		// verify generated code has correct values
+		content, err := os.ReadFile(filepath.Join(tmpDir, "error_code_enum.go"))
+		require.NoError(t, err)
+
+		contentStr := string(content)
+		assert.Contains(t, contentStr, "ErrorCodeNone       = ErrorCode{name: \"None\", value: -1}")
+		assert.Contains(t, contentStr, "ErrorCodeOK         = ErrorCode{name: \"OK\", value: 0}")
+		assert.Contains(t, contentStr, "ErrorCodeBadRequest = ErrorCode{name: \"BadRequest\", value: 400}")
+		assert.Contains(t, contentStr, "ErrorCodeNotFound   = ErrorCode{name: \"NotFound\", value: 404}")
+	})
+
+	t.Run("invalid negative expression", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		// create enum with invalid negative expression
+		enumFile := filepath.Join(tmpDir, "test.go")
+		err := os.WriteFile(enumFile, []byte(`package test
+
+type status int
+
+const (
+	statusInvalid status = -"not_a_number"
+	statusOK      status = 1
+)`), 0o644)
+		require.NoError(t, err)
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		// the type checker can't evaluate the value, parsing fails instead of guessing it
+		err = gen.Parse(tmpDir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "value of statusInvalid can't be evaluated")
+	})
+}
+
+func TestUnderlyingTypePreservation(t *testing.T) {
+	t.Run("uint8 type", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// check that underlying type was captured
+		assert.Equal(t, "uint8", gen.underlyingType)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+
+		// verify that the generated code uses uint8
+		assert.Contains(t, string(content), "value uint8")
+		assert.Contains(t, string(content), "func (e Status) Index() uint8")
+		assert.NotContains(t, string(content), "value int\n") // should not have plain int
+	})
+
+	t.Run("uint16 type", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("uint16Type", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		assert.Equal(t, "uint16", gen.underlyingType)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "uint16_type_enum.go"))
+		require.NoError(t, err)
+
+		assert.Contains(t, string(content), "value uint16")
+		assert.Contains(t, string(content), "func (e Uint16Type) Index() uint16")
+	})
+
+	t.Run("int32 type", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("int32Type", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		assert.Equal(t, "int32", gen.underlyingType)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "int32_type_enum.go"))
+		require.NoError(t, err)
+
+		assert.Contains(t, string(content), "value int32")
+		assert.Contains(t, string(content), "func (e Int32Type) Index() int32")
+		// check that values are correct (100, 101)
+		assert.Contains(t, string(content), "value: 100")
+		assert.Contains(t, string(content), "value: 101")
+	})
+
+	t.Run("byte type alias", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("byteType", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// byte is an alias for uint8, but ast gives us "byte"
+		assert.Equal(t, "byte", gen.underlyingType)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "byte_type_enum.go"))
+		require.NoError(t, err)
+
+		assert.Contains(t, string(content), "value byte")
+		assert.Contains(t, string(content), "func (e ByteType) Index() byte")
+	})
+
+	t.Run("rune type alias", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("runeType", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// rune is an alias for int32, but ast gives us "rune"
+		assert.Equal(t, "rune", gen.underlyingType)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "rune_type_enum.go"))
+		require.NoError(t, err)
+
+		assert.Contains(t, string(content), "value rune")
+		assert.Contains(t, string(content), "func (e RuneType) Index() rune")
+		// check that values are correct ('A' = 65, 'B' = 66)
+		assert.Contains(t, string(content), "value: 65")
+		assert.Contains(t, string(content), "value: 66")
+	})
+
+	t.Run("int type", func(t *testing.T) {
+		tmpDir := t.TempDir()
+
+		// create a test file with a type of int
+		testFile := `package test
+type some int
+const (
+	someUnknown some = iota
+	someActive
+)
+`
+		err := os.WriteFile(filepath.Join(tmpDir, "test.go"), []byte(testFile), 0o644)
+		require.NoError(t, err)
+
+		gen, err := New("some", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse(tmpDir)
+		require.NoError(t, err)
+
+		assert.Equal(t, "int", gen.underlyingType)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "some_enum.go"))
+		require.NoError(t, err)
+
+		// verify that the generated code uses int as default
+		assert.Contains(t, string(content), "value int")
+		assert.Contains(t, string(content), "func (e Some) Index() int")
+	})
+}
+
+func TestCaseInsensitiveParsing(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Parse("testdata")
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+
+	// verify that parsing uses strings.ToLower for case-insensitive matching
+	assert.Contains(t, string(content), "strings.ToLower(v)")
+
+	// verify the parse map has lowercase keys
+	assert.Contains(t, string(content), `"unknown":  StatusUnknown`)
+	assert.Contains(t, string(content), `"active":   StatusActive`)
+	assert.Contains(t, string(content), `"inactive": StatusInactive`)
+	assert.Contains(t, string(content), `"blocked":  StatusBlocked`)
+}
+
+func TestGeneratedCodeUsesVariables(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Parse("testdata")
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+
+	// verify that Values and Names are variables, not functions
+	assert.Contains(t, string(content), "var StatusValues = []Status")
+	assert.Contains(t, string(content), "var StatusNames = []string")
+
+	// should NOT have function signatures
+	assert.NotContains(t, string(content), "func StatusValues()")
+	assert.NotContains(t, string(content), "func StatusNames()")
+
+	// verify parse map is a variable
+	assert.Contains(t, string(content), "var _statusParseMap = map[string]Status")
+}
+
+func TestGetterWithDifferentTypes(t *testing.T) {
+	t.Run("getter with uint16", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("uint16Type", tmpDir)
+		require.NoError(t, err)
+		gen.SetGenerateGetter(true)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "uint16_type_enum.go"))
+		require.NoError(t, err)
+
+		// verify getter uses uint16
+		assert.Contains(t, string(content), "func GetUint16TypeByID(v uint16) (Uint16Type, error)")
+	})
+
+	t.Run("getter with int32", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("int32Type", tmpDir)
+		require.NoError(t, err)
+		gen.SetGenerateGetter(true)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		err = gen.Generate()
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "int32_type_enum.go"))
+		require.NoError(t, err)
+
+		// verify getter uses int32
+		assert.Contains(t, string(content), "func GetInt32TypeByID(v int32) (Int32Type, error)")
+		// verify it has correct values
+		assert.Contains(t, string(content), "case 100:")
+		assert.Contains(t, string(content), "case 101:")
+	})
+}
+
+func TestBinaryExpressionEdgeCases(t *testing.T) {
+	t.Run("multiplication with iota", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("mulDivType", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// check values
+		assert.Equal(t, 0, gen.values["mulDivTypeA"].value)
+		assert.Equal(t, 2, gen.values["mulDivTypeB"].value)
+		assert.Equal(t, 4, gen.values["mulDivTypeC"].value)
+	})
+
+	t.Run("right-side iota addition", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("rightIotaType", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// check values
+		assert.Equal(t, 10, gen.values["rightIotaTypeX"].value)
+		assert.Equal(t, 11, gen.values["rightIotaTypeY"].value)
+	})
+
+	t.Run("subtraction with iota", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("subType", tmpDir)
+		require.NoError(t, err)
+
+		err = gen.Parse("testdata")
+		require.NoError(t, err)
+
+		// check values
+		assert.Equal(t, 100, gen.values["subTypeA"].value)
+		assert.Equal(t, 99, gen.values["subTypeB"].value)
+		assert.Equal(t, 98, gen.values["subTypeC"].value)
+	})
+}
+
+func TestUnderscorePlaceholderConstants(t *testing.T) {
+	// test that underscore placeholders are skipped
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+	type status int
+	const (
+		statusFirst status = iota
+		_  // skip this value
+		statusSecond
+		_  // skip this too
+		statusThird
+	)`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	// check that underscore placeholders were skipped but iota still incremented
+	assert.Equal(t, 0, gen.values["statusFirst"].value)
+	assert.Equal(t, 2, gen.values["statusSecond"].value) // iota=2 (after _ at iota=1)
+	assert.Equal(t, 4, gen.values["statusThird"].value)  // iota=4 (after _ at iota=3)
+	_, exists := gen.values["_"]
+	assert.False(t, exists, "underscore should not be in values")
+}
+
+func TestDivisionOperationsWithIota(t *testing.T) {
+	// test division operations in applyIotaOperation
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+	type divType int
+	const (
+		divTypeA divType = iota / 2
+		divTypeB
+		divTypeC
+		divTypeD
+	)`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("divType", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	// iota/2: 0/2=0, 1/2=0, 2/2=1, 3/2=1
+	assert.Equal(t, 0, gen.values["divTypeA"].value)
+	assert.Equal(t, 0, gen.values["divTypeB"].value)
+	assert.Equal(t, 1, gen.values["divTypeC"].value)
+	assert.Equal(t, 1, gen.values["divTypeD"].value)
+}
+
+func TestSubtractionWithIota(t *testing.T) {
+	// test subtraction operations - both iota - N and N - iota
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+	type subType int
+	const (
+		subTypeA subType = 10 - iota  // 10 - 0 = 10
+		subTypeB              // 10 - 1 = 9
+		subTypeC              // 10 - 2 = 8
+		subTypeD subType = iota - 1   // 3 - 1 = 2
+		subTypeE              // 4 - 1 = 3
+	)`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("subType", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, gen.values["subTypeA"].value)
+	assert.Equal(t, 9, gen.values["subTypeB"].value)
+	assert.Equal(t, 8, gen.values["subTypeC"].value)
+	assert.Equal(t, 2, gen.values["subTypeD"].value)
+	assert.Equal(t, 3, gen.values["subTypeE"].value)
+}
+
+func TestEmptyConstBlock(t *testing.T) {
+	// test handling of empty const blocks
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+	type emptyType int
+	const (
+		// this const block has no values
+	)
+	const (
+		emptyTypeFirst emptyType = iota
+	)`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("emptyType", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, gen.values["emptyTypeFirst"].value)
+}
+
+func TestZeroBinaryExpression(t *testing.T) {
+	// test a binary expression that evaluates to 0 without iota
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+	type zeroType int
+	const (
+		zeroTypeA zeroType = 5 - 5  // plain binary expr that equals 0
+		zeroTypeB zeroType = iota   // should be 1
+	)`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("zeroType", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, gen.values["zeroTypeA"].value)
+	assert.Equal(t, 1, gen.values["zeroTypeB"].value)
+}
+
+func TestDivisionByZeroInQUO(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type divZero int
+const (
+	divZeroA divZero = 10 / iota  // division by zero when iota=0
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("divZero", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "value of divZeroA can't be evaluated")
+	assert.Contains(t, err.Error(), "division by zero")
+}
+
+func TestGenerateWriteFileError(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type writeErr int
+const (
+	writeErrA writeErr = iota
+	writeErrB
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("writeErr", "/nonexistent/path/that/cannot/be/created/because/parent/does/not/exist")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create output directory")
+}
+
+func TestEmptyValueSpec(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	// create a const block with type declaration but no names
+	src := `package test
+type emptySpec int
+const (
+	emptySpecA emptySpec = iota
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("emptySpec", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, gen.values["emptySpecA"].value)
+}
+
+func TestRightSideDivisionByIota(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type divByIota int
+const (
+	divByIotaA divByIota = iota     // 0
+	divByIotaB divByIota = 10 / iota  // 10/1 = 10
+	divByIotaC              // 10/2 = 5
+	divByIotaD              // 10/3 = 3
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("divByIota", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, gen.values["divByIotaA"].value)
+	assert.Equal(t, 10, gen.values["divByIotaB"].value)
+	assert.Equal(t, 5, gen.values["divByIotaC"].value)
+	assert.Equal(t, 3, gen.values["divByIotaD"].value)
+}
+
+func TestWriteFilePermissionError(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type perm int
+const (
+	permA perm = iota
+	permB
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	// create a read-only directory
+	readOnlyDir := filepath.Join(tmpDir, "readonly")
+	require.NoError(t, os.MkdirAll(readOnlyDir, 0o755))
+
+	gen, err := New("perm", readOnlyDir)
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	// make the directory read-only to cause write failure
+	require.NoError(t, os.Chmod(readOnlyDir, 0o555))
+	defer os.Chmod(readOnlyDir, 0o755) // restore permissions for cleanup
+
+	err = gen.Generate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to write output file")
+}
+
+func TestParseAliasComment(t *testing.T) {
+	tests := []struct {
+		name     string
+		comment  string
+		expected []string
+	}{
+		{"basic alias", "// enum:alias=rw", []string{"rw"}},
+		{"multiple aliases", "// enum:alias=rw,read-write", []string{"rw", "read-write"}},
+		{"with whitespace", "// enum:alias= rw , read-write ", []string{"rw", "read-write"}},
+		{"empty value", "// enum:alias=", nil},
+		{"followed by other directive", "// enum:alias=rw,read-write enum:since=v2", []string{"rw", "read-write"}},
+		{"empty between commas", "// enum:alias=a,,b", []string{"a", "b"}},
+		{"no alias directive", "// some comment", nil},
+		{"nil comment", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var comment *ast.CommentGroup
+			if tt.comment != "" {
+				comment = &ast.CommentGroup{
+					List: []*ast.Comment{{Text: tt.comment}},
+				}
+			}
+			result := parseAliasComment(comment)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseDocComment(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments []string // each string is one // comment line
+		expected string
+	}{
+		{
+			name:     "nil comment group",
+			comments: nil,
+			expected: "",
+		},
+		{
+			name:     "basic inline description",
+			comments: []string{"// Should be first"},
+			expected: "Should be first",
+		},
+		{
+			name:     "strips leading space after //",
+			comments: []string{"//  Should be first"},
+			expected: "Should be first",
+		},
+		{
+			name:     "description with parentheses",
+			comments: []string{"// Should be first (alphabetically would be fourth)"},
+			expected: "Should be first (alphabetically would be fourth)",
+		},
+		{
+			name:     "enum directive only — returns empty",
+			comments: []string{"// enum:alias=rw"},
+			expected: "",
+		},
+		{
+			name:     "empty comment text — returns empty",
+			comments: []string{"//"},
+			expected: "",
+		},
+		{
+			name:     "multi-line description joined with space",
+			comments: []string{"// First line", "// second line"},
+			expected: "First line second line",
+		},
+		{
+			name:     "directive line filtered, text line kept",
+			comments: []string{"// My description", "// enum:alias=a,b"},
+			expected: "My description",
+		},
+		{
+			name:     "directive line first, text line kept",
+			comments: []string{"// enum:alias=rw", "// Read-Write access level"},
+			expected: "Read-Write access level",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var comment *ast.CommentGroup
+			if tt.comments != nil {
+				list := make([]*ast.Comment, len(tt.comments))
+				for i, c := range tt.comments {
+					list[i] = &ast.Comment{Text: c}
+				}
+				comment = &ast.CommentGroup{List: list}
+			}
+			result := parseDocComment(comment)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestParseTextDirective(t *testing.T) {
+	group := func(lines ...string) *ast.CommentGroup {
+		list := make([]*ast.Comment, len(lines))
+		for i, l := range lines {
+			list[i] = &ast.Comment{Text: l}
+		}
+		return &ast.CommentGroup{List: list}
+	}
+	tests := []struct {
+		name     string
+		comments []*ast.CommentGroup
+		text     string
+		found    bool
+	}{
+		{"nil", []*ast.CommentGroup{nil, nil}, "", false},
+		{"plain comment", []*ast.CommentGroup{group("// Can log in")}, "", false},
+		{"trimmed", []*ast.CommentGroup{group("// enum:desc= Can log in, see docs ")}, "Can log in, see docs", true},
+		{"after alias", []*ast.CommentGroup{group("// enum:alias=rw enum:desc=Read and write")}, "Read and write", true},
+		{"before directive", []*ast.CommentGroup{group("// enum:desc=Read enum:since=v2")}, "Read", true},
+		{"inline first", []*ast.CommentGroup{group("// enum:desc=inline"), group("// enum:desc=doc")}, "inline", true},
+		{"doc", []*ast.CommentGroup{nil, group("// Active", "// enum:desc=doc")}, "doc", true},
+		{"no text", []*ast.CommentGroup{group("// enum:desc")}, "", true},
+		{"empty text", []*ast.CommentGroup{group("// enum:desc= enum:since=v2")}, "", true},
+		{"other directive", []*ast.CommentGroup{group("// enum:description=x")}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, found := parseTextDirective("desc", tt.comments...)
+			assert.Equal(t, tt.text, text)
+			assert.Equal(t, tt.found, found)
+		})
+	}
+}
+
+func TestGenerateDescriptions(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	// statusActive can log in
+	statusActive
+	statusBlocked // enum:desc=Blocked by "admin"
+	// statusDeleted is gone
+	// enum:desc=Deleted, kept for audit
+	statusDeleted
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e Status) Description() string { return StatusDescriptions[e] }")
+	assert.Contains(t, string(content), `var StatusDescriptions = map[Status]string{
+	StatusActive:  "statusActive can log in",
+	StatusBlocked: "Blocked by \"admin\"",
+	StatusDeleted: "Deleted, kept for audit",
+}`)
+	assert.Contains(t, string(content), "\t// statusDeleted is gone\n\tStatusDeleted ", "comment of the constant is kept")
+
+	// no descriptions, no method and map
+	gen, err = New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "Description")
+}
+
+func TestParseWithAliases(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type status int
+const (
+	statusActive status = iota // enum:alias=a,on
+	statusInactive             // enum:alias=i,off
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	// verify aliases are extracted
+	assert.Equal(t, []string{"a", "on"}, gen.values["statusActive"].aliases)
+	assert.Equal(t, []string{"i", "off"}, gen.values["statusInactive"].aliases)
+}
+
+func TestParseWithoutAliases(t *testing.T) {
+	// ensure backward compatibility - constants without aliases should have nil aliases
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type status int
+const (
+	statusActive status = iota
+	statusInactive
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	// verify no aliases
+	assert.Nil(t, gen.values["statusActive"].aliases)
+	assert.Nil(t, gen.values["statusInactive"].aliases)
+}
+
+func TestGenerateWithDuplicateAliases(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type status int
+const (
+	statusA status = iota // enum:alias=x
+	statusB               // enum:alias=x
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "duplicate alias")
+	assert.Contains(t, err.Error(), "x")
+}
+
+func TestGenerateWithCanonicalConflict(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type status int
+const (
+	statusActive status = iota // enum:alias=inactive
+	statusInactive
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "conflicts with canonical")
+}
+
+func TestGenerateAliasesInParseMap(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type status int
+const (
+	statusActive status = iota // enum:alias=a,on
+	statusInactive             // enum:alias=i,off
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+
+	// verify parse map contains canonical names
+	assert.Contains(t, string(content), `"active":`)
+	assert.Contains(t, string(content), `"inactive":`)
+
+	// verify parse map contains aliases
+	assert.Contains(t, string(content), `"a":`)
+	assert.Contains(t, string(content), `"on":`)
+	assert.Contains(t, string(content), `"i":`)
+	assert.Contains(t, string(content), `"off":`)
+
+	// verify aliases point to correct enum values
+	assert.Contains(t, string(content), `"a":        StatusActive`)
+	assert.Contains(t, string(content), `"on":       StatusActive`)
+	assert.Contains(t, string(content), `"i":        StatusInactive`)
+	assert.Contains(t, string(content), `"off":      StatusInactive`)
+}
+
+func TestGenerateConstComments(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `package testpkg
+
+type orderTest uint8
+
+const (
+	orderTestZero    orderTest = iota // Should be first (alphabetically would be fourth)
+	orderTestAlpha                    // Should be second (alphabetically would be first)
+	orderTestCharlie                  // Should be third (alphabetically would be third)
+	orderTestBravo                    // Should be fourth (alphabetically would be second)
+)
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "order.go"), []byte(src), 0o600)
+	require.NoError(t, err)
+
+	gen, err := New("orderTest", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "order_test_enum.go"))
+	require.NoError(t, err)
+	out := string(content)
+
+	// block comment still present
+	assert.Contains(t, out, "// Public constants for orderTest values")
+
+	// individual comments present
+	assert.Contains(t, out, "// Should be first (alphabetically would be fourth)")
+	assert.Contains(t, out, "// Should be second (alphabetically would be first)")
+	assert.Contains(t, out, "// Should be third (alphabetically would be third)")
+	assert.Contains(t, out, "// Should be fourth (alphabetically would be second)")
+
+	// each comment appears before its constant (check relative positions)
+	zeroCommentPos := strings.Index(out, "// Should be first (alphabetically would be fourth)")
+	zeroConstPos := strings.Index(out, "OrderTestZero =")
+	assert.Less(t, zeroCommentPos, zeroConstPos, "comment should appear before constant")
+
+	alphaCommentPos := strings.Index(out, "// Should be second (alphabetically would be first)")
+	alphaConstPos := strings.Index(out, "OrderTestAlpha =")
+	assert.Less(t, alphaCommentPos, alphaConstPos, "comment should appear before constant")
+}
+
+func TestGenerateConstCommentsDocAboveFallback(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `package testpkg
+
+type orderTest uint8
+
+const (
+	// The zero value — default when unset
+	orderTestZero orderTest = iota
+	// Alphabetically first but declared second
+	orderTestAlpha
+)
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "order.go"), []byte(src), 0o600)
+	require.NoError(t, err)
+
+	gen, err := New("orderTest", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "order_test_enum.go"))
+	require.NoError(t, err)
+	out := string(content)
+
+	assert.Contains(t, out, "// The zero value — default when unset")
+	assert.Contains(t, out, "// Alphabetically first but declared second")
+}
+
+func TestGenerateConstCommentsInlineOverridesDoc(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `package testpkg
+
+type orderTest uint8
+
+const (
+	// doc comment — should NOT appear
+	orderTestZero orderTest = iota // inline comment wins
+)
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "order.go"), []byte(src), 0o600)
+	require.NoError(t, err)
+
+	gen, err := New("orderTest", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "order_test_enum.go"))
+	require.NoError(t, err)
+	out := string(content)
+
+	assert.Contains(t, out, "// inline comment wins")
+	assert.NotContains(t, out, "// doc comment")
+}
+
+func TestGenerateNoCommentNoDiff(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `package testpkg
+
+type status uint8
+
+const (
+	statusActive   status = iota
+	statusInactive
+)
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o600)
+	require.NoError(t, err)
+
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	out := string(content)
+
+	// no individual doc comment lines in the var block
+	varBlockStart := strings.Index(out, "// Public constants for status values")
+	varBlockEnd := strings.Index(out, "// StatusValues contains")
+	varBlock := out[varBlockStart:varBlockEnd]
+	assert.NotContains(t, varBlock, "//\n", "var block should have no stray empty comment lines")
+	assert.NotContains(t, varBlock, "\t//\n", "var block should have no stray empty comment lines")
+	assert.Contains(t, out, "StatusActive")
+	assert.Contains(t, out, "StatusInactive")
+}
+
+func TestGenerateAliasAndCommentCoexist(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	src := `package testpkg
+
+type status uint8
+
+const (
+	statusReadWrite status = iota // enum:alias=rw,read-write
+	// Administrator access
+	statusAdmin // enum:alias=adm
+)
+`
+	err := os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o600)
+	require.NoError(t, err)
+
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	out := string(content)
+
+	// aliases still work
+	assert.Contains(t, out, `"rw":`)
+	assert.Contains(t, out, `"read-write":`)
+	assert.Contains(t, out, `"adm":`)
+
+	// statusAdmin has doc comment
+	assert.Contains(t, out, "// Administrator access")
+
+	// no doc comment line before StatusReadWrite (directive-only inline → no comment emitted)
+	assert.NotContains(t, out, "//\n\tStatusReadWrite =")
+}
+
+func TestGenerateLowerCaseWithMixedCaseAlias(t *testing.T) {
+	// test that mixed-case aliases work with -lower flag
+	// this was a bug: parse map keys are always lowercase but Parse() didn't normalize input
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "test.go")
+	src := `package test
+type permission int
+const (
+	permissionNone      permission = iota // enum:alias=n
+	permissionReadWrite                   // enum:alias=RW,read-write
+)
+`
+	require.NoError(t, os.WriteFile(testFile, []byte(src), 0o644))
+
+	gen, err := New("permission", tmpDir)
+	require.NoError(t, err)
+	gen.SetLowerCase(true) // enable -lower flag
+	err = gen.Parse(tmpDir)
+	require.NoError(t, err)
+
+	err = gen.Generate()
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "permission_enum.go"))
+	require.NoError(t, err)
+
+	// verify generated parse function uses strings.ToLower
+	assert.Contains(t, string(content), "strings.ToLower(v)")
+
+	// verify aliases are stored lowercase in map
+	assert.Contains(t, string(content), `"rw":`)
+	assert.Contains(t, string(content), `"read-write":`)
+
+	// verify parse function will handle mixed case input correctly by checking the template output
+	// the parse function should always use strings.ToLower(v) for lookup
+	assert.Contains(t, string(content), `_permissionParseMap[strings.ToLower(v)]`)
+}
+
+func TestTypeDirectiveOptions(t *testing.T) {
+	t.Run("options applied from type doc comment", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		src := `package test
+
+//go:generate enum -type=status
+//enum: lower, sql getter
+//enum: yaml
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+`
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o600))
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(tmpDir))
+		assert.True(t, gen.lowerCase)
+		assert.True(t, gen.generateSQL)
+		assert.True(t, gen.generateGetter)
+		assert.True(t, gen.generateYAML)
+		assert.False(t, gen.generateBSON)
+
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `name: "active"`)
+		assert.Contains(t, string(content), "func GetStatusByID(")
+		assert.Contains(t, string(content), "func (e Status) Value() (driver.Value, error)")
+	})
+
+	t.Run("grouped type declaration and explicit false", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		src := `package test
+
+type (
+	// enum: sql=false, bson
+	status uint8
+	other  int
+)
+
+const statusActive status = 1
+`
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o600))
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		gen.SetGenerateSQL(true)
+		require.NoError(t, gen.Parse(tmpDir))
+		assert.False(t, gen.generateSQL)
+		assert.True(t, gen.generateBSON)
+	})
+
+	t.Run("unknown option", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		src := `package test
+
+//enum: lower, xml
+type status uint8
+
+const statusActive status = 1
+`
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o600))
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		err = gen.Parse(tmpDir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid enum directive for type status: unknown option "xml"`)
+	})
+
+	t.Run("invalid bool value", func(t *testing.T) {
+		gen, err := New("status", "")
+		require.NoError(t, err)
+		err = gen.SetOption("sql", "maybe")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid value "maybe" for option "sql"`)
+	})
+}
+
+func TestAnnotatedTypes(t *testing.T) {
+	tmpDir := t.TempDir()
+	src := `package test
+
+//enum: lower
+type status uint8
+
+// jobStatus has a regular comment only
+type jobStatus uint8
+
+type (
+	//enum: sql
+	priority int
+	color    int
+)
+
+//enum: lower
+type Exported int
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(src), 0o600))
+
+	types, err := AnnotatedTypes(tmpDir, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"priority", "status"}, types)
+
+	_, err = AnnotatedTypes("/nonexistent/dir", nil)
+	require.Error(t, err)
+}
+
+func TestParseTypeCheckedValues(t *testing.T) {
+	tmpDir := t.TempDir()
+	src := `package test
+
+import "time"
+
+type flag uint16
+
+const flagBase = 10
+
+const (
+	flagNone flag = 0
+	flagRead flag = 1 << iota
+	flagWrite
+	flagExec
+	flagAdmin = flagExec * (flagBase - 2)
+	flagShifted flag = (flagRead << 4) | flagWrite
+	flagTimeout = flag(time.Second / time.Millisecond)
+)
+
+const (
+	flagCount int  = 6        // prefixed constant of another type
+	readOnly  flag = flagRead // constant of the type without the prefix
+)
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "flag.go"), []byte(src), 0o600))
+
+	gen, err := New("flag", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+
+	assert.Equal(t, 0, gen.values["flagNone"].value)
+	assert.Equal(t, 2, gen.values["flagRead"].value)
+	assert.Equal(t, 4, gen.values["flagWrite"].value)
+	assert.Equal(t, 8, gen.values["flagExec"].value)
+	assert.Equal(t, 64, gen.values["flagAdmin"].value)
+	assert.Equal(t, 36, gen.values["flagShifted"].value)
+	assert.Equal(t, 1000, gen.values["flagTimeout"].value, "constant depending on an imported package")
+	assert.Equal(t, 2, gen.values["readOnly"].value)
+	for _, v := range gen.buildValues() {
+		if v.PrivateName == "readOnly" {
+			assert.Equal(t, "FlagReadOnly", v.PublicName)
+			assert.Equal(t, "ReadOnly", v.Name)
+		}
+	}
+	assert.NotContains(t, gen.values, "flagBase", "untyped prefixed constant is not a value of the type")
+	assert.NotContains(t, gen.values, "flagCount")
+}
+
+func TestGenerateSplitFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetGenerateSQL(true)
+	gen.SetGenerateYAML(true)
+	gen.SetSplitFiles(true)
+	require.NoError(t, gen.Generate())
+
+	mainFile, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(mainFile), "database/sql/driver")
+	assert.NotContains(t, string(mainFile), "gopkg.in/yaml.v3")
+	assert.NotContains(t, string(mainFile), "func (e *Status) Scan(")
+	assert.NotContains(t, string(mainFile), "func (e Status) MarshalYAML(")
+
+	sqlFile, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_sql.go"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(sqlFile), generatedHeader))
+	assert.Contains(t, string(sqlFile), `"database/sql/driver"`)
+	assert.Contains(t, string(sqlFile), "func (e *Status) Scan(value interface{}) error {")
+
+	yamlFile, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_yaml.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(yamlFile), `"gopkg.in/yaml.v3"`)
+	assert.Contains(t, string(yamlFile), "func (e Status) MarshalYAML() (any, error) {")
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_bson.go"))
+
+	// user file with the same name is not touched
+	userFile := filepath.Join(tmpDir, "status_enum_bson.go")
+	require.NoError(t, os.WriteFile(userFile, []byte("package status\n"), 0o600))
+
+	// regenerate without split, feature files are merged back and stale ones removed
+	gen.SetSplitFiles(false)
+	require.NoError(t, gen.Generate())
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_sql.go"))
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_yaml.go"))
+	assert.FileExists(t, userFile)
+
+	mainFile, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(mainFile), "func (e *Status) Scan(value interface{}) error {")
+	assert.Contains(t, string(mainFile), "func (e Status) MarshalYAML() (any, error) {")
+}
+
+func TestGenerateSplitTags(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetGenerateSQL(true)
+	gen.SetGenerateYAML(true)
+	gen.SetSplitFiles(true)
+	require.NoError(t, gen.SetOption("split-tag", "yaml:!noyaml"))
+	gen.SetSplitTag("sql", "postgres || sqlite")
+	header := filepath.Join(t.TempDir(), "header.txt")
+	require.NoError(t, os.WriteFile(header, []byte("Copyright Example Corp.\n"), 0o600))
+	gen.SetHeader(header)
+	require.NoError(t, gen.Generate())
+
+	sqlFile, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_sql.go"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(sqlFile), "//go:build postgres || sqlite\n\n// Copyright Example Corp.\n\n"+generatedHeader))
+	yamlFile, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_yaml.go"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(yamlFile), "//go:build !noyaml\n\n"))
+	mainFile, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(mainFile), "//go:build")
+
+	// files are still recognized as generated and removed without split
+	gen.SetSplitFiles(false)
+	gen.SetSplitTag("sql", "")
+	gen.SetSplitTag("yaml", "")
+	require.NoError(t, gen.Generate())
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_sql.go"))
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_yaml.go"))
+
+	tests := []struct{ name, option, value, err string }{
+		{name: "no split", option: "split", value: "false", err: "split-tag of type status requires split"},
+		{name: "unknown feature", option: "split-tag", value: "json:fast", err: `unknown split-tag feature "json", expected sql, bson, yaml, toml or pgx`},
+		{name: "invalid constraint", option: "split-tag", value: "yaml:a &&", err: `invalid split-tag constraint "a &&" of feature yaml: unexpected end of expression`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, err := New("status", t.TempDir())
+			require.NoError(t, err)
+			require.NoError(t, gen.Parse("testdata"))
+			gen.SetSplitFiles(true)
+			gen.SetSplitTag("sql", "postgres")
+			require.NoError(t, gen.SetOption(tt.option, tt.value))
+			require.EqualError(t, gen.Generate(), tt.err)
+		})
+	}
+	require.EqualError(t, gen.SetOption("split-tag", "yaml"), `invalid split-tag "yaml", expected feature:constraint`)
+}
+
+func TestGetFeatureFileName(t *testing.T) {
+	assert.Equal(t, "status_enum_sql.go", getFeatureFileName("status_enum.go", "sql"))
+	assert.Equal(t, "job_status_enum_yaml.go", getFeatureFileName("job_status_enum.go", "yaml"))
+}
+
+func TestGeneratePgx(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	outDir := t.TempDir()
+	gen, err := New("jobStatus", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.SetOption("pgx", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"github.com/jackc/pgx/v5/pgtype"`)
+	assert.Contains(t, string(content), "func (e JobStatus) TextValue() (pgtype.Text, error) {")
+	assert.Contains(t, string(content), "func (e *JobStatus) ScanText(v pgtype.Text) error {")
+	assert.Contains(t, string(content), "func RegisterJobStatusType(ctx context.Context, conn *pgx.Conn) error {")
+	assert.Contains(t, string(content), `for _, name := range []string{"job_status", "_job_status"} {`)
+
+	gen.SetSplitFiles(true)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(outDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "pgx")
+	split, err := os.ReadFile(filepath.Join(outDir, "job_status_enum_pgx.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(split), "func (e *JobStatus) ScanText(v pgtype.Text) error {")
+
+	assert.Equal(t, "job_status", sqlTypeName("jobStatus"))
+}
+
+func TestShiftWithIota(t *testing.T) {
+	src := `package test
+
+type perm uint8
+
+const (
+	permRead perm = 1 << iota
+	permWrite
+	permExec
+)
+
+const (
+	permNone perm = iota << 2
+	permLow
+	permHigh
+)
+
+const (
+	permMax perm = 64 >> iota
+	permHalf
+)
+`
+	expected := map[string]int{
+		"permRead": 1, "permWrite": 2, "permExec": 4,
+		"permNone": 0, "permLow": 4, "permHigh": 8,
+		"permMax": 64, "permHalf": 32,
+	}
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "perm.go"), []byte(src), 0o600))
+	gen, err := New("perm", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	for name, val := range expected {
+		require.Contains(t, gen.values, name)
+		assert.Equal(t, val, gen.values[name].value, name)
+	}
+}
+
+func TestGenerateSet(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusBlocked
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("set", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	for _, s := range []string{
+		"\t\"math/bits\"\n",
+		"type StatusSet struct {\n\tbits [1]uint64\n}",
+		"func NewStatusSet(values ...Status) StatusSet {",
+		"func _statusOrdinal(v Status) int {",
+		"func (s *StatusSet) Add(values ...Status) {",
+		"func (s *StatusSet) Remove(values ...Status) {",
+		"func (s StatusSet) Contains(v Status) bool {",
+		"func (s StatusSet) Union(other StatusSet) StatusSet {",
+		"func (s StatusSet) Intersect(other StatusSet) StatusSet {",
+		"func (s StatusSet) All() func(yield func(Status) bool) {",
+		"func (s StatusSet) MarshalText() ([]byte, error) {",
+		`// ParseStatusSet converts comma-separated names, e.g. "unknown,active", to a set.`,
+	} {
+		assert.Contains(t, string(content), s)
+	}
+
+	t.Run("more than 64 values", func(t *testing.T) {
+		var sb strings.Builder
+		sb.WriteString("package test\n\ntype code uint8\n\nconst (\n\tcode0 code = iota\n")
+		for i := 1; i < 70; i++ {
+			sb.WriteString("\tcode" + strconv.Itoa(i) + "\n")
+		}
+		sb.WriteString(")\n")
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "code.go"), []byte(sb.String()), 0o600))
+		gen, err := New("code", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateSet(true)
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(outDir, "code_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\tbits [2]uint64\n")
+	})
+}
+
+func TestGenerateFlags(t *testing.T) {
+	src := `package test
+
+type perm uint8
+
+const (
+	permNone perm = 0
+	permRead perm = 1 << iota
+	permWrite
+	permExec
+)
+`
+	t.Run("generates flags type", func(t *testing.T) {
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "perm.go"), []byte(src), 0o600))
+
+		gen, err := New("perm", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateFlags(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "perm_enum.go"))
+		require.NoError(t, err)
+		for _, s := range []string{
+			"type PermFlags uint8",
+			"func NewPermFlags(values ...Perm) PermFlags {",
+			"func (f PermFlags) Has(v Perm) bool {",
+			"func (f PermFlags) Set(v Perm) PermFlags { return f | PermFlags(v.value) }",
+			"func (f PermFlags) Clear(v Perm) PermFlags { return f &^ PermFlags(v.value) }",
+			"func (f PermFlags) Toggle(v Perm) PermFlags { return f ^ PermFlags(v.value) }",
+			"func (f PermFlags) String() string {",
+			`return strings.Join(parts, "|")`,
+			"func ParsePermFlags(s string) (PermFlags, error) {",
+			"func (f *PermFlags) UnmarshalText(text []byte) error {",
+		} {
+			assert.Contains(t, string(content), s)
+		}
+	})
+
+	t.Run("not generated by default", func(t *testing.T) {
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "perm.go"), []byte(src), 0o600))
+
+		gen, err := New("perm", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "perm_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "PermFlags")
+	})
+
+	t.Run("rejects non power of two values", func(t *testing.T) {
+		srcDir := t.TempDir()
+		badSrc := src + "\nconst permAll perm = 7\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "perm.go"), []byte(badSrc), 0o600))
+
+		gen, err := New("perm", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.SetOption("flags", ""))
+		require.NoError(t, gen.Parse(srcDir))
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "flags value permAll must be zero or a power of two, got 7")
+	})
+}
+
+func TestGenerateJSONMap(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetGenerateJSONMap(enabled)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		if !enabled {
+			assert.NotContains(t, string(content), "MarshalStatusMapJSON")
+			assert.NotContains(t, string(content), `"encoding/json"`)
+			continue
+		}
+		assert.Contains(t, string(content), `"encoding/json"`)
+		assert.Contains(t, string(content), "func MarshalStatusMapJSON[V any](m map[Status]V) ([]byte, error) {")
+		assert.Contains(t, string(content), "for _, k := range StatusValues {")
+	}
+}
+
+func TestGenerateMap(t *testing.T) {
+	generate := func(t *testing.T, src string) string {
+		t.Helper()
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateMap(true)
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	t.Run("unique values", func(t *testing.T) {
+		content := generate(t, "package test\ntype status uint8\nconst (\n\tstatusA status = iota\n\tstatusB\n\tstatusC\n)\n")
+		assert.Contains(t, content, "type StatusMap[V any] struct {")
+		assert.Contains(t, content, "values [3]V")
+		assert.Contains(t, content, "func (m *StatusMap[V]) Get(k Status) (V, bool) {")
+		assert.Contains(t, content, "func (m *StatusMap[V]) Set(k Status, v V) bool {")
+		assert.Contains(t, content, "func (m *StatusMap[V]) Range(fn func(k Status, v V) bool) {")
+		assert.Contains(t, content, "func (m *StatusMap[V]) All() func(yield func(Status, V) bool) {")
+		assert.Contains(t, content, "switch v.value {\n\tcase 0:\n\t\treturn 0\n\tcase 1:\n\t\treturn 1\n\tcase 2:\n\t\treturn 2\n\t}")
+	})
+
+	t.Run("sparse values", func(t *testing.T) {
+		content := generate(t, "package test\ntype status uint16\nconst (\n\tstatusA status = 1\n\tstatusB status = 100\n\tstatusC status = 5000\n)\n")
+		assert.Contains(t, content, "values [3]V", "arrays are as long as the number of values, not the largest value")
+		assert.NotContains(t, content, "map[Status]V")
+		assert.Contains(t, content, "switch v.value {\n\tcase 1:\n\t\treturn 0\n\tcase 100:\n\t\treturn 1\n\tcase 5000:\n\t\treturn 2\n\t}")
+	})
+
+	t.Run("duplicate values", func(t *testing.T) {
+		content := generate(t, "package test\ntype status uint8\nconst (\n\tstatusA status = iota\n\tstatusB\n\tstatusC = statusB\n)\n")
+		assert.Contains(t, content, "values [3]V")
+		assert.Contains(t, content, "switch v.name {\n\tcase \"A\":\n\t\treturn 0\n\tcase \"B\":\n\t\treturn 1\n\tcase \"C\":\n\t\treturn 2\n\t}")
+	})
+}
+
+func TestUniqueValues(t *testing.T) {
+	assert.True(t, uniqueValues(nil))
+	assert.True(t, uniqueValues([]Value{{Literal: "1"}, {Literal: "2"}}))
+	assert.False(t, uniqueValues([]Value{{Literal: "1"}, {Literal: "2"}, {Literal: "1"}}))
+}
+
+func TestStringBackedEnum(t *testing.T) {
+	src := `package test
+
+type role string
+
+const (
+	roleNone   role = ""
+	roleAdmin  role = "admin" // enum:alias=root
+	roleEditor role = "content-editor"
+	roleViewer      = role("viewer")
+)
+
+const roleHeader = "X-Role" // untyped string
+`
+	t.Run("generate", func(t *testing.T) {
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "role.go"), []byte(src), 0o600))
+
+		gen, err := New("role", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		assert.Equal(t, "admin", gen.values["roleAdmin"].str)
+		assert.Equal(t, "viewer", gen.values["roleViewer"].str)
+
+		gen.SetGenerateGetter(true)
+		gen.SetGenerateSQL(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "role_enum.go"))
+		require.NoError(t, err)
+		for _, s := range []string{
+			"value string\n",
+			"func (e Role) Index() string { return e.value }",
+			`RoleNone   = Role{name: "", value: ""}`,
+			`RoleAdmin  = Role{name: "admin", value: "admin"}`,
+			`RoleEditor = Role{name: "content-editor", value: "content-editor"}`,
+			`RoleViewer = Role{name: "viewer", value: "viewer"}`,
+			`"content-editor": RoleEditor,`,
+			`"root":           RoleAdmin,`,
+			"func GetRoleByID(v string) (Role, error) {",
+			`case "viewer":`,
+			`if v.Index() == "" {`,
+			`var _ role = role("")`,
+		} {
+			assert.Contains(t, string(content), s)
+		}
+	})
+
+	t.Run("unsupported features", func(t *testing.T) {
+		srcDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "role.go"), []byte(src), 0o600))
+
+		gen, err := New("role", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateFlags(true)
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "flags are not supported for string-backed type role")
+
+		err = gen.Describe(io.Discard)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "describe is not supported for string-backed type role")
+	})
+
+	t.Run("duplicate values with getter", func(t *testing.T) {
+		srcDir := t.TempDir()
+		dupSrc := "package test\ntype role string\nconst (\n\troleA role = \"a\"\n\troleB role = \"a\"\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "role.go"), []byte(dupSrc), 0o600))
+
+		gen, err := New("role", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateGetter(true)
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `multiple names for value "a"`)
+	})
+
+	t.Run("value requiring escaping", func(t *testing.T) {
+		srcDir := t.TempDir()
+		badSrc := "package test\ntype role string\nconst roleQuoted role = `say \"hi\"`\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "role.go"), []byte(badSrc), 0o600))
+
+		gen, err := New("role", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "contains characters requiring escaping")
+	})
+}
+
+func TestConstantReferences(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusBlocked
+	statusLast = statusBlocked
+	statusMax  = statusBlocked + 1
+	statusNext = 10 - statusMax
+)
+
+const (
+	statusBase status = 100
+	statusFirst = iota + statusBase
+	statusSecond
+)
+`
+	expected := map[string]int{
+		"statusUnknown": 0, "statusActive": 1, "statusBlocked": 2,
+		"statusLast": 2, "statusMax": 3, "statusNext": 7,
+		"statusBase": 100, "statusFirst": 101, "statusSecond": 102,
+	}
+
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o600))
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	for name, val := range expected {
+		require.Contains(t, gen.values, name)
+		assert.Equal(t, val, gen.values[name].value, name)
+	}
+}
+
+func TestVersionedValues(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusLegacy // enum:until=v2
+	// statusSuspended added in v2 API
+	// enum:since=v2
+	statusSuspended
+	statusTemp // enum:alias=tmp enum:since=v2.1 enum:until=v3
+)
+`
+	t.Run("generate", func(t *testing.T) {
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		assert.Equal(t, "v2", gen.values["statusSuspended"].since)
+		assert.Equal(t, "statusSuspended added in v2 API", gen.values["statusSuspended"].comment)
+		assert.Equal(t, []string{"tmp"}, gen.values["statusTemp"].aliases)
+		assert.Equal(t, "v2.1", gen.values["statusTemp"].since)
+		assert.Equal(t, "v3", gen.values["statusTemp"].until)
+
+		gen.SetLowerCase(true)
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `"strconv"`)
+		assert.Contains(t, string(content), "func (e Status) AvailableIn(version string) bool {")
+		assert.Contains(t, string(content), "case \"legacy\":\n\t\treturn _statusCompareVersions(version, \"v2\") <= 0\n")
+		assert.Contains(t, string(content), `return _statusCompareVersions(version, "v2.1") >= 0 && _statusCompareVersions(version, "v3") <= 0`)
+		assert.Contains(t, string(content), "func StatusValuesForVersion(version string) []Status {")
+		assert.Contains(t, string(content), "func _statusCompareVersions(a, b string) int {")
+	})
+
+	t.Run("not generated without annotations", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "AvailableIn")
+		assert.NotContains(t, string(content), `"strconv"`)
+	})
+
+	t.Run("invalid versions", func(t *testing.T) {
+		srcDir := t.TempDir()
+		badSrc := "package test\ntype status uint8\nconst (\n\tstatusA status = iota // enum:since=latest\n" +
+			"\tstatusB // enum:since=v3 enum:until=v2.5\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(badSrc), 0o600))
+
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid version "latest" for statusA`)
+		assert.Contains(t, err.Error(), "version range of statusB is empty: since v3 is after until v2.5")
+	})
+}
+
+func TestPublicNameOverride(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown  status = iota
+	statusreadonly        // enum:public=StatusReadOnly
+	// enum:public=StatusRW
+	statusreadwrite
+)
+`
+	t.Run("generate", func(t *testing.T) {
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		assert.Equal(t, "StatusReadOnly", gen.values["statusreadonly"].public)
+		assert.Empty(t, gen.values["statusreadonly"].comment)
+
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "StatusReadOnly = Status{name: \"Readonly\", value: 1}")
+		assert.Contains(t, string(content), "StatusRW       = Status{name: \"Readwrite\", value: 2}")
+		assert.Contains(t, string(content), "\"readwrite\": StatusRW,")
+		assert.NotContains(t, string(content), "StatusReadonly")
+	})
+
+	t.Run("rename keeps pinned name", func(t *testing.T) {
+		srcDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+		gen, err := New("status", srcDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+
+		_, err = gen.RenameValue("StatusRW", "ReadWrite", srcDir)
+		require.NoError(t, err)
+		content, err := os.ReadFile(filepath.Join(srcDir, "status.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\tstatusReadWrite\n")
+		assert.Contains(t, string(content), "enum:public=StatusRW")
+	})
+
+	t.Run("invalid names", func(t *testing.T) {
+		srcDir := t.TempDir()
+		badSrc := "package test\ntype status uint8\nconst (\n\tstatusA status = iota // enum:public=statusLower\n" +
+			"\tstatusB // enum:public=StatusC\n\tstatusC\n\tstatusD // enum:public=Status\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(badSrc), 0o600))
+
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid public name "statusLower" for statusA, expected exported identifier`)
+		assert.Contains(t, err.Error(), "duplicate public name StatusC: used by both statusB and statusC")
+		assert.Contains(t, err.Error(), "public name Status of statusD conflicts with the type name")
+	})
+}
+
+func TestGenerateCompletion(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive         // user can log in
+	statusReadWrite      // enum:alias=rw
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("completion", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e *Status) Set(v string) error {")
+	assert.Contains(t, string(content), `func (e *Status) Type() string { return "status" }`)
+	assert.Contains(t, string(content), "var _statusCompletions = []string{\n\t\"unknown\",\n\t\"active\\tuser can log in\",\n"+
+		"\t\"readwrite\",\n\t\"rw\",\n}")
+	assert.Contains(t, string(content), "func StatusCompletionFunc[C any, D ~int](_ C, _ []string, toComplete string) ([]string, D) {")
+
+	gen.SetGenerateCompletion(false)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "CompletionFunc")
+}
+
+func TestGenerateFormatter(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e Status) GoString() string {")
+	assert.Contains(t, string(content), "\tswitch e {\n\tcase StatusUnknown:\n\t\treturn \"StatusUnknown\"\n")
+	assert.NotContains(t, string(content), "GoNames", "no map built at init")
+	assert.Contains(t, string(content), `return fmt.Sprintf("Status{name: %q, value: %d}", e.name, e.value)`)
+	assert.NotContains(t, string(content), "Format(f fmt.State")
+
+	require.NoError(t, gen.SetOption("formatter", ""))
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e Status) Format(f fmt.State, verb rune) {")
+	assert.Contains(t, string(content), `fmt.Fprintf(f, "%s=%d", e.name, e.value)`)
+	assert.Contains(t, string(content), "case verb == 'd':")
+
+	t.Run("string backed", func(t *testing.T) {
+		src := `package test
+
+type role string
+
+const (
+	roleAdmin role = "admin"
+	roleUser  role = "user"
+)
+`
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "role.go"), []byte(src), 0o600))
+		gen, err := New("role", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateFormatter(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "role_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `return fmt.Sprintf("Role{name: %q, value: %q}", e.name, e.value)`)
+		assert.Contains(t, string(content), `fmt.Fprintf(f, "%s=%s", e.name, e.value)`)
+		assert.NotContains(t, string(content), "case verb == 'd':")
+	})
+}
+
+func TestPinnedNames(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusInProgress // enum:alias=running enum:name=in-progress
+	// enum:name=done.v2
+	statusDone
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `StatusInProgress = Status{name: "in-progress", value: 1}`)
+	assert.Contains(t, string(content), `StatusDone       = Status{name: "done.v2", value: 2}`)
+	assert.Contains(t, string(content), `"in-progress": StatusInProgress,`)
+	assert.Contains(t, string(content), `"running":     StatusInProgress,`)
+	assert.NotContains(t, string(content), `"inprogress"`)
+
+	m := gen.Manifest()
+	assert.Equal(t, "in-progress", m.Values[1].String)
+	assert.Empty(t, m.Values[0].String)
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name, consts, err string
+		}{
+			{"duplicate", "statusActive status = iota // enum:name=done\n\tstatusDone",
+				`duplicate name "Done": used by both statusActive and statusDone, names are case-insensitive`},
+			{"alias conflict", "statusActive status = iota // enum:name=on\n\tstatusOff // enum:alias=on",
+				`alias "on" for statusOff conflicts with canonical name of statusActive`},
+			{"escaping", `statusActive status = iota // enum:name=a"b`,
+				`name "a\"b" of statusActive contains characters requiring escaping`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				dir := t.TempDir()
+				src := "package test\n\ntype status uint8\n\nconst (\n\t" + tt.consts + "\n)\n"
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(src), 0o600))
+				gen, err := New("status", t.TempDir())
+				require.NoError(t, err)
+				require.NoError(t, gen.Parse(dir))
+				err = gen.Generate()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+			})
+		}
+	})
+}
+
+func TestDeprecatedValues(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	// statusLegacy is the old active state
+	// enum:deprecated=use StatusActive
+	statusLegacy
+	statusOld // enum:alias=ancient enum:deprecated
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	gen.SetGenerateCompletion(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `func (e Status) Deprecated() (bool, string) {
+	switch e {
+	case StatusLegacy:
+		return true, "use StatusActive"
+	case StatusOld:
+		return true, ""
+	}
+	return false, ""
+}`)
+	assert.Contains(t, string(content), `	// statusLegacy is the old active state
+	//
+	// Deprecated: use StatusActive
+	StatusLegacy = Status{name: "legacy", value: 2}
+	// Deprecated: StatusOld is kept for existing data only.
+	StatusOld = Status{name: "old", value: 3}`)
+	assert.Contains(t, string(content), "var StatusNames = []string{\n\t\"unknown\",\n\t\"active\",\n\t\"legacy\",\n\t\"old\",\n}")
+	assert.Contains(t, string(content), `"old":     StatusOld,`)
+
+	require.NoError(t, gen.SetOption("hide-deprecated", ""))
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "// StatusNames contains all possible enum names except deprecated ones\n"+
+		"var StatusNames = []string{\n\t\"unknown\",\n\t\"active\",\n}")
+	assert.Contains(t, string(content), "var _statusCompletions = []string{\n\t\"unknown\",\n\t\"active\",\n}")
+	assert.Contains(t, string(content), `"old":     StatusOld,`, "deprecated values are still parsed")
+	assert.Contains(t, string(content), `"ancient": StatusOld,`)
+	assert.Contains(t, string(content), "\tStatusOld,\n}", "deprecated values are kept in StatusValues")
+
+	// no deprecated values, no method
+	gen, err = New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "Deprecated")
+}
+
+func TestGenerateEnt(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusLegacy // enum:deprecated
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	t.Run("values and sql", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetLowerCase(true)
+		gen.SetHideDeprecated(true)
+		require.NoError(t, gen.SetOption("ent", ""))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `func (Status) Values() []string {
+	return []string{
+		"unknown",
+		"active",
+		"legacy",
+	}
+}`, "deprecated values are stored in existing rows")
+		assert.Contains(t, string(content), "func (e Status) Value() (driver.Value, error) {")
+		assert.Contains(t, string(content), "func (e *Status) Scan(value interface{}) error {")
+	})
+
+	t.Run("split", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateEnt(true)
+		gen.SetSplitFiles(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func (Status) Values() []string {")
+		assert.NotContains(t, string(content), "driver.Value")
+		assert.FileExists(t, filepath.Join(outDir, "status_enum_sql.go"))
+	})
+
+	t.Run("method conflict", func(t *testing.T) {
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.AddMethod("Values", "Active")
+		require.EqualError(t, gen.Generate(), "method Values conflicts with a generated method of type status")
+	})
+}
+
+func TestGenerateNull(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	t.Run("wrapper", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.NoError(t, gen.SetOption("null", ""))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "type NullStatus struct {\n\tStatus Status\n\tValid  bool")
+		assert.Contains(t, string(content), "func (n NullStatus) Value() (driver.Value, error) {\n\tif !n.Valid {\n\t\treturn nil, nil")
+		assert.Contains(t, string(content), "func (n *NullStatus) Scan(value interface{}) error {\n\tif value == nil {\n\t\t*n = NullStatus{}")
+		assert.Contains(t, string(content), "func (n NullStatus) MarshalJSON() ([]byte, error) {")
+		assert.Contains(t, string(content), "func (n *NullStatus) UnmarshalJSON(data []byte) error {")
+		assert.Contains(t, string(content), "func (e *Status) Scan(value interface{}) error {", "null implies sql")
+	})
+
+	t.Run("split", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateNull(true)
+		gen.SetSplitFiles(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "NullStatus")
+		content, err = os.ReadFile(filepath.Join(outDir, "status_enum_sql.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\t\"encoding/json\"\n")
+		assert.Contains(t, string(content), "type NullStatus struct {")
+	})
+}
+
+func TestGenerateRedis(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	t.Run("names", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.NoError(t, gen.SetOption("redis", ""))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func (e Status) MarshalBinary() ([]byte, error) {\n\treturn e.MarshalText()\n}")
+		assert.Contains(t, string(content), "func (e *Status) UnmarshalBinary(data []byte) error {\n\treturn e.UnmarshalText(data)\n}")
+		assert.Contains(t, string(content), "func (e Status) RedisArg() interface{} {\n\treturn e.name\n}")
+		assert.Contains(t, string(content), "func (e *Status) RedisScan(src interface{}) error {")
+		assert.NotContains(t, string(content), "\tcase int64:\n\t\treturn e.UnmarshalText(")
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateRedis(true)
+		gen.SetMarshalNumber(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func (e Status) RedisArg() interface{} {\n\treturn int64(e.value)\n}")
+		assert.Contains(t, string(content), "\tcase int64:\n\t\treturn e.UnmarshalText(strconv.AppendInt(nil, v, 10))")
+	})
+}
+
+func TestPredicateMethods(t *testing.T) {
+	src := `package test
+
+// enum: lower, method=IsTerminal:Blocked,statusDeleted, sql
+// enum: method=IsActive:StatusActive
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusBlocked
+	statusDeleted
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	assert.Equal(t, []Method{{Name: "IsTerminal", Values: []string{"Blocked", "statusDeleted"}},
+		{Name: "IsActive", Values: []string{"StatusActive"}}}, gen.methods)
+	assert.True(t, gen.lowerCase)
+	assert.True(t, gen.generateSQL)
+
+	require.NoError(t, gen.Generate())
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "// IsTerminal reports whether e is one of StatusBlocked, StatusDeleted\n"+
+		"func (e Status) IsTerminal() bool {\n\tswitch e {\n\tcase StatusBlocked, StatusDeleted:\n\t\treturn true\n\t}\n\treturn false\n}")
+	assert.Contains(t, string(content), "func (e Status) IsActive() bool {\n\tswitch e {\n\tcase StatusActive:\n")
+
+	t.Run("invalid", func(t *testing.T) {
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.EqualError(t, gen.SetOption("method", "IsTerminal"), `invalid method "IsTerminal", expected Name:Value,Value`)
+		require.EqualError(t, gen.SetOption("method", "IsTerminal:"), `invalid method "IsTerminal:", expected Name:Value,Value`)
+
+		require.NoError(t, gen.Parse(srcDir))
+		gen.AddMethod("isTerminal", "Blocked")
+		gen.AddMethod("String", "Blocked")
+		gen.AddMethod("IsActive", "Active")
+		gen.AddMethod("IsGone", "Removed")
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid method name "isTerminal", expected exported identifier`)
+		assert.Contains(t, err.Error(), "method String conflicts with a generated method of type status")
+		assert.Contains(t, err.Error(), "duplicate method IsActive")
+		assert.Contains(t, err.Error(), "method IsGone: unknown value Removed of type status")
+	})
+}
+
+func TestGeneratorOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	assert.Empty(t, gen.Output())
+
+	gen.SetGenerateSQL(true)
+	gen.SetSplitFiles(true)
+	require.NoError(t, gen.Generate())
+	out := gen.Output()
+	require.Len(t, out, 2)
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	sum := sha256.Sum256(content)
+	assert.Equal(t, OutputFile{Path: filepath.Join(tmpDir, "status_enum.go"), SHA256: hex.EncodeToString(sum[:]), Changed: true}, out[0])
+	assert.Equal(t, filepath.Join(tmpDir, "status_enum_sql.go"), out[1].Path)
+
+	// same code again, nothing changed
+	require.NoError(t, gen.Generate())
+	require.Len(t, gen.Output(), 2)
+	assert.False(t, gen.Output()[0].Changed)
+	assert.False(t, gen.Output()[1].Changed)
+
+	// merged back, the feature file is removed
+	gen.SetSplitFiles(false)
+	require.NoError(t, gen.Generate())
+	out = gen.Output()
+	require.Len(t, out, 2)
+	assert.True(t, out[0].Changed)
+	assert.Equal(t, OutputFile{Path: filepath.Join(tmpDir, "status_enum_sql.go"), Changed: true, Removed: true}, out[1])
+}
+
+func TestRequireVersion(t *testing.T) {
+	tests := []struct {
+		name, version, required, err string
+	}{
+		{name: "not required", version: "v1.0.0"},
+		{name: "same", version: "v1.3.0", required: "v1.3.0"},
+		{name: "newer", version: "v1.10.0", required: "v1.9"},
+		{name: "release after pre-release", version: "v1.3.0", required: "v1.3.0-rc.1"},
+		{name: "without v prefix", version: "v1.3.0", required: "1.3.0"},
+		{name: "pseudo-version after tag", version: "v1.3.1-0.20250101000000-abcdefabcdef", required: "v1.3.0"},
+		{name: "pseudo-version without tag", version: "v0.0.0-20250101000000-abcdefabcdef", required: "v9.0.0"},
+		{name: "pre-release", version: "v1.3.0-rc.1", required: "v1.3.0", err: `enum generator v1.3.0-rc.1 is older than required ` +
+			`v1.3.0, run "enum self-update" or go install github.com/go-pkgz/enum@v1.3.0`},
+		{name: "pseudo-version before tag", version: "v1.2.1-0.20250101000000-abcdefabcdef", required: "v1.3.0",
+			err: `enum generator v1.2.1-0.20250101000000-abcdefabcdef is older than required v1.3.0, ` +
+				`run "enum self-update" or go install github.com/go-pkgz/enum@v1.3.0`},
+		{name: "development build", version: "(devel)", required: "v9.0.0"},
+		{name: "older", version: "v1.2.5", required: "v1.3.0", err: `enum generator v1.2.5 is older than required v1.3.0, ` +
+			`run "enum self-update" or go install github.com/go-pkgz/enum@v1.3.0`},
+		{name: "invalid", version: "v1.2.5", required: "latest", err: `invalid required version "latest", expected format like v1.3.0`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, err := New("status", t.TempDir())
+			require.NoError(t, err)
+			require.NoError(t, gen.Parse("testdata"))
+			gen.SetVersion(tt.version)
+			require.NoError(t, gen.SetOption("require-version", tt.required))
+			err = gen.Generate()
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestConstBlockOrder(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+// enum:order=2
+const (
+	statusArchived status = iota + 10
+	statusDeleted
+)
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+
+// extension values, listed before archived ones
+// enum:order=1
+const statusSuspended status = 20
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	assert.Equal(t, "2", gen.values["statusDeleted"].order)
+	assert.Equal(t, "1", gen.values["statusSuspended"].order)
+	assert.Empty(t, gen.values["statusActive"].order)
+
+	require.NoError(t, gen.Generate())
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "var StatusValues = []Status{\n\tStatusUnknown,\n\tStatusActive,\n"+
+		"\tStatusSuspended,\n\tStatusArchived,\n\tStatusDeleted,\n}")
+	assert.Contains(t, string(content), "var StatusNames = []string{\n\t\"Unknown\",\n\t\"Active\",\n"+
+		"\t\"Suspended\",\n\t\"Archived\",\n\t\"Deleted\",\n}")
+
+	t.Run("invalid order", func(t *testing.T) {
+		srcDir := t.TempDir()
+		badSrc := "package test\ntype status uint8\n// enum:order=last\nconst (\n\tstatusA status = iota\n\tstatusB\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(badSrc), 0o600))
+
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.EqualError(t, gen.Generate(), `invalid order "last" of the const block with statusA, expected integer`)
+	})
+}
+
+func TestValidateNames(t *testing.T) {
+	generate := func(t *testing.T, src string, opts ...string) error {
+		t.Helper()
+		srcDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		for _, opt := range opts {
+			require.NoError(t, gen.SetOption(opt, ""))
+		}
+		return gen.Generate()
+	}
+
+	t.Run("empty name", func(t *testing.T) {
+		// a constant named as the type doesn't compile, the type checker rejects it before the name check
+		srcDir := t.TempDir()
+		src := "package test\ntype status uint8\nconst (\n\tstatus status = iota\n\tstatusActive\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		err = gen.Parse(srcDir)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status redeclared")
+	})
+
+	t.Run("duplicate names", func(t *testing.T) {
+		err := generate(t, "package test\ntype status uint8\nconst (\n\tstatusActive status = iota\n\tstatusactive\n)\n")
+		require.EqualError(t, err, `duplicate name "Active": used by both statusActive and statusactive, names are case-insensitive`)
+
+		err = generate(t, "package test\ntype status string\nconst (\n\tstatusA status = \"a\"\n\tstatusB status = \"A\"\n)\n")
+		require.EqualError(t, err, `duplicate name "A": used by both statusA and statusB, names are case-insensitive`)
+	})
+
+	t.Run("numeric name", func(t *testing.T) {
+		src := "package test\ntype status uint8\nconst (\n\tstatusOK status = iota\n\tstatus404\n)\n"
+		require.NoError(t, generate(t, src))
+		err := generate(t, src, "tolerant")
+		require.EqualError(t, err, `name "404" of status404 is a number, it can't be told apart from numeric values`)
+	})
+
+	t.Run("keyword package", func(t *testing.T) {
+		outDir := filepath.Join(t.TempDir(), "func")
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "package enum\n")
+	})
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v1", "v2", -1},
+		{"v2", "v2", 0},
+		{"v2", "v2.0.0", 0},
+		{"v2.1", "v2", 1},
+		{"v10", "v9", 1},
+		{"2.1", "v2.1", 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, compareVersions(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+
+	assert.True(t, isValidVersion("v2"))
+	assert.True(t, isValidVersion("2.1.3"))
+	assert.False(t, isValidVersion(""))
+	assert.False(t, isValidVersion("v"))
+	assert.False(t, isValidVersion("v2."))
+	assert.False(t, isValidVersion("v2-beta"))
+}
+
+func TestParseBuildConstraints(t *testing.T) {
+	tmpDir := t.TempDir()
+	otherOS := "windows"
+	if runtime.GOOS == "windows" {
+		otherOS = "linux"
+	}
+	files := map[string]string{
+		"status.go": "package test\n\ntype status uint8\n\nconst statusUnknown status = 0\n",
+		"status_tagged.go": "//go:build enterprise\n\npackage test\n\n" +
+			"const (\n\tstatusAudit status = 10\n\tstatusShared status = 20\n)\n",
+		"status_default.go":         "//go:build !enterprise\n\npackage test\n\nconst statusShared status = 2\n",
+		"status_" + otherOS + ".go": "package test\n\nconst statusPlatform status = 30\n",
+		"status_ignored.go":         "//go:build ignore\n\npackage main\n\nconst statusIgnored = 1\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o600))
+	}
+
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	assert.Len(t, gen.values, 2)
+	assert.Equal(t, 2, gen.values["statusShared"].value)
+	assert.NotContains(t, gen.values, "statusAudit")
+	assert.NotContains(t, gen.values, "statusPlatform")
+
+	gen, err = New("status", "")
+	require.NoError(t, err)
+	gen.SetBuildTags([]string{"enterprise"})
+	require.NoError(t, gen.Parse(tmpDir))
+	assert.Len(t, gen.values, 3)
+	assert.Equal(t, 10, gen.values["statusAudit"].value)
+	assert.Equal(t, 20, gen.values["statusShared"].value)
+}
+
+func TestGenerateChecked(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetGenerateChecked(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func NewStatusFromInt(v int) (Status, error) {")
+	assert.Contains(t, string(content), "if int(e.value) == v {")
+	assert.Contains(t, string(content), "func MustStatusFromInt(v int) Status {")
+
+	gen.SetGenerateChecked(false)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "NewStatusFromInt")
+}
+
+func TestGenerateCustomTemplate(t *testing.T) {
+	t.Run("template file", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		tmplFile := filepath.Join(tmpDir, "custom.tmpl")
+		tmpl := `// Code generated by enum generator; DO NOT EDIT.
+package {{.Package}}
+
+// {{.Type | title}}Names lists names of {{.Type}} values
+var {{.Type | title}}Names = []string{ {{range .Values}}"{{.Name}}", {{end}} }
+`
+		require.NoError(t, os.WriteFile(tmplFile, []byte(tmpl), 0o600))
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetTemplate(tmplFile)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "package enum")
+		assert.Contains(t, string(content), `var StatusNames = []string{"Unknown", "Active", "Inactive", "Blocked"}`)
+		assert.NotContains(t, string(content), "func ParseStatus")
+	})
+
+	t.Run("template directory overrides named template", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		tmplDir := filepath.Join(tmpDir, "templates")
+		require.NoError(t, os.Mkdir(tmplDir, 0o755))
+		tmpl := `{{define "sql"}}
+// Value implements the driver.Valuer interface
+func (e {{.Type | title}}) Value() (driver.Value, error) {
+	return e.String(), nil
+}
+{{end}}`
+		require.NoError(t, os.WriteFile(filepath.Join(tmplDir, "sql.tmpl"), []byte(tmpl), 0o600))
+
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetGenerateSQL(true)
+		gen.SetTemplate(tmplDir)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func ParseStatus", "embedded main template is kept")
+		assert.Contains(t, string(content), "return e.String(), nil")
+		assert.NotContains(t, string(content), "func (e *Status) Scan(")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+
+		gen.SetTemplate(filepath.Join(tmpDir, "missing.tmpl"))
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load template")
+
+		gen.SetTemplate(tmpDir)
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no *.tmpl files")
+
+		bad := filepath.Join(tmpDir, "bad.tmpl")
+		require.NoError(t, os.WriteFile(bad, []byte("{{.Type"), 0o600))
+		gen.SetTemplate(bad)
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse template")
+	})
+}
+
+func TestGenerateEqual(t *testing.T) {
+	t.Run("with aliases", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("permission", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata/integration"))
+		gen.SetLowerCase(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "permission_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func (e Permission) Equal(other Permission) bool { return e == other }")
+		assert.Contains(t, string(content), "func (e Permission) EqualString(s string) bool {")
+		assert.Contains(t, string(content), `case PermissionReadWrite:
+		return strings.EqualFold(s, "rw") || strings.EqualFold(s, "read-write")`)
+	})
+
+	t.Run("without aliases", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func (e Status) EqualString(s string) bool {\n\tif e.name == \"\" {\n"+
+			"\t\treturn false\n\t}\n\tif strings.EqualFold(s, e.name) {\n\t\treturn true\n\t}\n\treturn false\n}")
+	})
+}
+
+func TestGeneratePtr(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e Status) Ptr() *Status { return &e }")
+	assert.Contains(t, string(content), `func StatusFromPtr(p *Status, def Status) Status {
+	if p == nil {
+		return def
+	}
+	return *p
+}`)
+}
+
+func TestGenerateNextPrev(t *testing.T) {
+	t.Run("stops at the ends", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `func (e Status) Next() (Status, bool) {
+	i := _statusOrdinal(e)
+	if i < 0 || i+1 == len(StatusValues) {
+		return e, false
+	}
+	return StatusValues[i+1], true
+}`)
+		assert.Contains(t, string(content), `func (e Status) Prev() (Status, bool) {
+	i := _statusOrdinal(e)
+	if i <= 0 {
+		return e, false
+	}
+	return StatusValues[i-1], true
+}`)
+	})
+
+	t.Run("wraps around", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.SetOption("wrap", ""))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\treturn StatusValues[(i+1)%len(StatusValues)], true\n")
+		assert.Contains(t, string(content), "\treturn StatusValues[(i+len(StatusValues)-1)%len(StatusValues)], true\n")
+	})
+}
+
+func TestGeneratePacked(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("packed", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "type Status struct {\n\tord   uint8 // position in StatusValues plus one, zero for the zero value\n\tvalue uint8\n}")
+	assert.Contains(t, string(content), "func (e Status) String() string { return e.name() }")
+	assert.Contains(t, string(content), "const _statusName = \"unknownactiveinactiveblocked\"\n")
+	assert.Contains(t, string(content), "var _statusIndex = [...]uint8{0, 0, 7, 13, 21, 28}\n")
+	assert.Contains(t, string(content), "\tStatusActive   = Status{ord: 2, value: 1}\n")
+	assert.Contains(t, string(content), "var StatusNames = []string{\n\t_statusName[0:7],\n\t_statusName[7:13],\n")
+	assert.Contains(t, string(content), "\treturn int(v.ord) - 1 // -1 for the zero value\n")
+	assert.Contains(t, string(content), "return []byte(e.name()), nil")
+	assert.NotContains(t, string(content), "name  string")
+}
+
+func TestPackNames(t *testing.T) {
+	packed := packNames([]Value{{Name: "Active"}, {Name: "On"}}, true)
+	assert.Equal(t, &PackedNames{Names: "activeon", Index: []int{0, 0, 6, 8}, IndexType: "uint8", OrdType: "uint8",
+		Values: []PackedValue{{Ord: 1, Start: 0, End: 6}, {Ord: 2, Start: 6, End: 8}}}, packed)
+
+	assert.Equal(t, "uint8", uintType(255))
+	assert.Equal(t, "uint16", uintType(256))
+	assert.Equal(t, "uint32", uintType(1<<16))
+	assert.Equal(t, "uint64", uintType(1<<32))
+}
+
+func TestGenerateMarshalTextTable(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `func (e Status) MarshalText() ([]byte, error) {
+	if i := _statusOrdinal(e); i >= 0 {
+		b := _statusText[i]
+		return b[:len(b):len(b)], nil // appending to the slice copies it
+	}
+	return []byte(e.name), nil
+}`)
+	assert.Contains(t, string(content), "var _statusText = [...][]byte{\n\t[]byte(\"unknown\"),\n\t[]byte(\"active\"),\n")
+
+	gen.SetMarshalNumber(true)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "_statusText", "numbers are appended without a table")
+}
+
+func TestGenerateErrorValues(t *testing.T) {
+	for _, mode := range []string{"map", "switch"} {
+		t.Run(mode, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			gen, err := New("status", tmpDir)
+			require.NoError(t, err)
+			require.NoError(t, gen.Parse("testdata"))
+			gen.SetLowerCase(true)
+			gen.SetParseMode(mode)
+			require.NoError(t, gen.SetOption("error-values", ""))
+			require.NoError(t, gen.Generate())
+
+			content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+			require.NoError(t, err)
+			assert.Contains(t, string(content),
+				"\treturn Status{}, fmt.Errorf(\"invalid status %q, valid values: unknown, active, inactive, blocked\", v)\n}")
+			assert.NotContains(t, string(content), `fmt.Errorf("invalid status: %s", v)`)
+		})
+	}
+}
+
+func TestErrorValues(t *testing.T) {
+	values := []Value{
+		{Name: "Active", Aliases: []string{"on", "ACTIVE"}},
+		{Name: "Legacy", Deprecated: true},
+		{Name: "Rate%"},
+	}
+	assert.Equal(t, "active, on, legacy, rate%%", errorValues(values, true, false))
+	assert.Equal(t, "Active, on, Rate%%", errorValues(values, false, true), "deprecated values are hidden")
+
+	many := make([]Value, 0, errorValuesLimit+5)
+	for i := range errorValuesLimit + 5 {
+		many = append(many, Value{Name: "v" + strconv.Itoa(i)})
+	}
+	res := errorValues(many, false, false)
+	assert.True(t, strings.HasPrefix(res, "v0, v1, v2, "), res)
+	assert.True(t, strings.HasSuffix(res, ", v19 and 5 more"), res)
+}
+
+func TestGenerateFallback(t *testing.T) {
+	for _, mode := range []string{"map", "switch"} {
+		t.Run(mode, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			gen, err := New("status", tmpDir)
+			require.NoError(t, err)
+			require.NoError(t, gen.Parse("testdata"))
+			gen.SetParseMode(mode)
+			require.NoError(t, gen.SetOption("fallback", "unknown"))
+			require.NoError(t, gen.Generate())
+
+			content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+			require.NoError(t, err)
+			assert.Contains(t, string(content), "var OnUnknownStatus func(raw string)\n")
+			assert.Contains(t, string(content), `	if OnUnknownStatus != nil {
+		OnUnknownStatus(v)
+	}
+	return StatusUnknown, nil
+}`)
+			assert.NotContains(t, string(content), `fmt.Errorf("invalid status: %s", v)`)
+		})
+	}
+
+	t.Run("numbers", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetMarshalNumber(true)
+		gen.SetFallback("StatusBlocked")
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `	if OnUnknownStatus != nil {
+		OnUnknownStatus(strconv.FormatInt(n, 10))
+	}
+	return StatusBlocked, nil
+}`)
+	})
+
+	t.Run("unknown value", func(t *testing.T) {
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetFallback("paused")
+		require.EqualError(t, gen.Generate(), "fallback: unknown value paused of type status")
+	})
+}
+
+func TestGenerateNullValue(t *testing.T) {
+	generate := func(t *testing.T, opts map[string]string) (string, error) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetGenerateSQL(true)
+		for name, value := range opts {
+			require.NoError(t, gen.SetOption(name, value))
+		}
+		if err = gen.Generate(); err != nil {
+			return "", err
+		}
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		return string(content), nil
+	}
+
+	content, err := generate(t, map[string]string{"null-value": "blocked"})
+	require.NoError(t, err)
+	assert.Contains(t, content, "\tif value == nil {\n\t\t*e = StatusBlocked\n\t\treturn nil\n\t}\n")
+	assert.NotContains(t, content, "no zero value defined")
+
+	content, err = generate(t, map[string]string{"null-strict": ""})
+	require.NoError(t, err)
+	assert.Contains(t, content, "\tif value == nil {\n\t\treturn fmt.Errorf(\"cannot scan nil into Status: NULL is not allowed\")\n\t}\n")
+
+	content, err = generate(t, map[string]string{})
+	require.NoError(t, err)
+	assert.Contains(t, content, "// try to find zero value", "zero value by default")
+
+	_, err = generate(t, map[string]string{"null-value": "paused"})
+	require.EqualError(t, err, "null-value: unknown value paused of type status")
+	_, err = generate(t, map[string]string{"null-value": "StatusBlocked", "null-strict": ""})
+	require.EqualError(t, err, "null-value and null-strict are mutually exclusive")
+}
+
+func TestGenerateParseAny(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func ParseStatusAny(v any) (Status, error) {\n\tswitch v := v.(type) {\n\tcase Status:\n")
+	assert.Contains(t, string(content), "\tcase []byte:\n\t\treturn ParseStatus(string(v))\n")
+	assert.Contains(t, string(content), "\tcase int64:\n\t\treturn _statusFromNumber(v)\n")
+	assert.Contains(t, string(content), "\tcase uint64:\n\t\treturn _statusFromUnsigned(v)\n")
+	assert.Contains(t, string(content), "\tcase fmt.Stringer:\n\t\treturn ParseStatus(v.String())\n")
+
+	t.Run("string-backed", func(t *testing.T) {
+		srcDir, outDir := t.TempDir(), t.TempDir()
+		src := "package test\n\ntype role string\n\nconst (\n\troleAdmin role = \"admin\"\n\troleUser role = \"user\"\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "role.go"), []byte(src), 0o600))
+		gen, err := New("role", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "role_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func ParseRoleAny(v any) (Role, error) {")
+		assert.NotContains(t, string(content), "_roleFromNumber", "no lookup by number")
+	})
+}
+
+func TestGenerateSliceHelpers(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "// ParseStatusSlice converts names separated by sep, e.g. \"unknown,active\" with \",\"")
+	assert.Contains(t, string(content), "func ParseStatusSlice(s, sep string) ([]Status, error) {")
+	assert.Contains(t, string(content), "\t\treturn nil, fmt.Errorf(\"invalid status list %q: %w\", s, errors.Join(errs...))\n")
+	assert.Contains(t, string(content), "func StatusJoin(values []Status, sep string) string {")
+}
+
+func TestGenerateEnv(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("env", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\t\"os\"\n")
+	assert.Contains(t, string(content), `func StatusFromEnv(key string, def Status) (Status, error) {
+	v, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(v) == "" {
+		return def, nil
+	}`)
+	assert.Contains(t, string(content), "func MustStatusFromEnv(key string, def Status) Status {")
+
+	gen.SetGenerateEnv(false)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "FromEnv")
+	assert.NotContains(t, string(content), "\t\"os\"\n")
+}
+
+func TestGenerateSwag(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("swag", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "//\t// @Param status query string false \"status\" Enums(unknown,active,inactive,blocked)\n")
+	assert.Contains(t, string(content),
+		"//\tStatus Status `json:\"status\" swaggertype:\"string\" enums:\"unknown,active,inactive,blocked\"`\n")
+	assert.Contains(t, string(content), "const StatusSwaggerEnums = \"unknown,active,inactive,blocked\"\n")
+
+	values := []Value{{Name: "Active", Index: 1}, {Name: "Legacy", Index: 5, Deprecated: true}}
+	assert.Equal(t, "Active,Legacy", swagEnums(values, false, false, false))
+	assert.Equal(t, "1,5", swagEnums(values, true, true, false), "numbers with marshal-number")
+	assert.Equal(t, "active", swagEnums(values, true, false, true), "deprecated values are hidden")
+}
+
+func TestGenerateBounds(t *testing.T) {
+	src := `package test
+
+type level int
+
+const (
+	levelInfo  level = 1
+	levelDebug level = -1
+	levelError level = 3
+	levelWarn  level = 2
+)
+
+type role string
+
+const (
+	roleUser  role = "user"
+	roleAdmin role = "admin"
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "types.go"), []byte(src), 0o600))
+
+	outDir := t.TempDir()
+	gen, err := New("level", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.Generate())
+	content, err := os.ReadFile(filepath.Join(outDir, "level_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "const LevelCount = 4\n")
+	assert.Contains(t, string(content), "func LevelFirst() Level { return LevelInfo }")
+	assert.Contains(t, string(content), "func LevelLast() Level { return LevelWarn }")
+	assert.Contains(t, string(content), "func MinLevel() Level { return LevelDebug }")
+	assert.Contains(t, string(content), "func MaxLevel() Level { return LevelError }")
+
+	gen, err = New("role", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(outDir, "role_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "const RoleCount = 2\n")
+	assert.Contains(t, string(content), "func RoleLast() Role { return RoleAdmin }")
+	assert.NotContains(t, string(content), "MinRole", "no numeric values")
+}
+
+func TestGenerateTestHelpers(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("testhelpers", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_helpers_test.go"))
+	require.NoError(t, err)
+	assert.True(t, isGeneratedFile(content))
+	assert.Contains(t, string(content), "import (\n\t\"math/rand\"\n\t\"reflect\"\n)\n")
+	assert.Contains(t, string(content), `func RandomStatus(r *rand.Rand) Status {
+	if r == nil {
+		return StatusValues[rand.Intn(len(StatusValues))] //nolint:gosec // not for security
+	}
+	return StatusValues[r.Intn(len(StatusValues))]
+}`)
+	assert.Contains(t, string(content), `func (Status) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(RandomStatus(r))
+}`)
+	assert.NotContains(t, string(content), "rapid")
+	content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "RandomStatus")
+
+	stale, err := gen.Check()
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+
+	gen.SetGenerateTestHelpers(false)
+	gen.SetGenerateRapid(true)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum_helpers_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\t\"pgregory.net/rapid\"\n", "rapid implies test helpers")
+	assert.Contains(t, string(content), `func RapidStatus() *rapid.Generator[Status] {
+	return rapid.SampledFrom(StatusValues)
+}`)
+
+	gen.SetGenerateRapid(false)
+	require.NoError(t, gen.Generate())
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_helpers_test.go"), "stale file is removed")
+}
+
+func TestGenerateTests(t *testing.T) {
+	t.Run("generated", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetGenerateSQL(true)
+		require.NoError(t, gen.SetOption("tests", ""))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_test.go"))
+		require.NoError(t, err)
+		assert.True(t, isGeneratedFile(content))
+		for _, s := range []string{
+			"func TestStatusEnum(t *testing.T) {",
+			`t.Run("parse", func(t *testing.T) {`,
+			`t.Run("text", func(t *testing.T) {`,
+			`t.Run("json", func(t *testing.T) {`,
+			`t.Run("sql", func(t *testing.T) {`,
+			"if err := got.UnmarshalText(text); err != nil || got != v {",
+		} {
+			assert.Contains(t, string(content), s)
+		}
+		assert.NotContains(t, string(content), `t.Run("yaml"`)
+		assert.NotContains(t, string(content), `t.Run("getter"`)
+
+		gen.SetGenerateTests(false)
+		require.NoError(t, gen.Generate())
+		assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_test.go"), "stale file is removed")
+	})
+
+	t.Run("hand-written tests are kept", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		testFile := filepath.Join(tmpDir, "status_enum_test.go")
+		require.NoError(t, os.WriteFile(testFile, []byte("package enum\n"), 0o600))
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetGenerateTests(true)
+		require.EqualError(t, gen.Generate(), "status_enum_test.go exists and is not generated, rename it to generate tests")
+		content, err := os.ReadFile(testFile)
+		require.NoError(t, err)
+		assert.Equal(t, "package enum\n", string(content))
+	})
+}
+
+func TestGenerateExamples(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("examples", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum_example_test.go"))
+	require.NoError(t, err)
+	assert.True(t, isGeneratedFile(content))
+	assert.Contains(t, string(content), "func ExampleParseStatus() {\n\tv, err := ParseStatus(\"unknown\")")
+	assert.Contains(t, string(content), "\tfmt.Println(string(text))\n\t// Output: unknown\n}")
+	assert.Contains(t, string(content), "func ExampleStatusIter() {")
+	assert.Contains(t, string(content), "\t// Output:\n\t// unknown\n\t// active\n}")
+
+	gen.SetMarshalNumber(true)
+	require.NoError(t, gen.Generate())
+	content, err = os.ReadFile(filepath.Join(outDir, "status_enum_example_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\tfmt.Println(string(text))\n\t// Output: 0\n}")
+
+	gen.SetGenerateExamples(false)
+	require.NoError(t, gen.Generate())
+	assert.NoFileExists(t, filepath.Join(outDir, "status_enum_example_test.go"), "stale file is removed")
+}
+
+func TestGenerateTemplateExtras(t *testing.T) {
+	tmpDir := t.TempDir()
+	imports := filepath.Join(tmpDir, "imports.tmpl")
+	require.NoError(t, os.WriteFile(imports, []byte(`"log/slog"`), 0o600))
+	methods := filepath.Join(tmpDir, "methods.tmpl")
+	require.NoError(t, os.WriteFile(methods, []byte(`
+// LogValue implements slog.LogValuer
+func (e {{.Type | title}}) LogValue() slog.Value { return slog.StringValue(e.name) }
+`), 0o600))
+
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetTemplateExtras(map[string]string{"extraImports": imports, "extraMethods": methods})
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\t\"log/slog\"\n")
+	assert.Contains(t, string(content), "func (e Status) LogValue() slog.Value { return slog.StringValue(e.name) }")
+	assert.Contains(t, string(content), "func ParseStatus(", "embedded template is kept")
+
+	t.Run("errors", func(t *testing.T) {
+		gen.SetTemplateExtras(map[string]string{"extraFields": methods})
+		err := gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown template hook "extraFields"`)
+
+		gen.SetTemplateExtras(map[string]string{"extraMethods": filepath.Join(tmpDir, "missing.tmpl")})
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load template snippet")
+
+		bad := filepath.Join(tmpDir, "bad.tmpl")
+		require.NoError(t, os.WriteFile(bad, []byte("{{.Type"), 0o600))
+		gen.SetTemplateExtras(map[string]string{"extraMethods": bad})
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to parse template snippet")
+	})
+}
+
+func TestGenerateTo(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+
+	var buf bytes.Buffer
+	require.NoError(t, gen.GenerateTo(&buf))
+	assert.True(t, strings.HasPrefix(buf.String(), generatedHeader))
+	assert.Contains(t, buf.String(), "func ParseStatus(")
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum.go"), "nothing written to disk")
+
+	// the output path is not created
+	outDir := filepath.Join(tmpDir, "out")
+	outGen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, outGen.Parse("testdata"))
+	outGen.SetGenerateJSONSchema(true)
+	var outBuf bytes.Buffer
+	require.NoError(t, outGen.GenerateTo(&outBuf))
+	assert.NoDirExists(t, outDir)
+	assert.Contains(t, outBuf.String(), "func ParseStatus(")
+
+	// same code as written to the file
+	require.NoError(t, gen.Generate())
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Equal(t, string(content), buf.String())
+
+	gen.SetSplitFiles(true)
+	err = gen.GenerateTo(&buf)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "can't be written as a single source")
+}
+
+func TestCheck(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	fileName := filepath.Join(tmpDir, "status_enum.go")
+
+	res, err := gen.Check()
+	require.NoError(t, err)
+	assert.Equal(t, []string{fileName + ": missing"}, res)
+
+	require.NoError(t, gen.Generate())
+	res, err = gen.Check()
+	require.NoError(t, err)
+	assert.Empty(t, res)
+
+	// option changed after generation
+	gen.SetLowerCase(true)
+	res, err = gen.Check()
+	require.NoError(t, err)
+	assert.Equal(t, []string{fileName + ": out of date"}, res)
+	gen.SetLowerCase(false)
+
+	// feature file left from split generation
+	gen.SetGenerateSQL(true)
+	gen.SetSplitFiles(true)
+	require.NoError(t, gen.Generate())
+	gen.SetSplitFiles(false)
+	res, err = gen.Check()
+	require.NoError(t, err)
+	assert.Equal(t, []string{fileName + ": out of date", filepath.Join(tmpDir, "status_enum_sql.go") + ": stale, should be removed"}, res)
+
+	content, err := os.ReadFile(fileName)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "func (e Status) Value()", "check doesn't write files")
+}
+
+func TestGenerateJSONExample(t *testing.T) {
+	t.Run("lower case", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetLowerCase(true)
+		gen.SetGenerateJSONExample(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func StatusJSONExample() map[string]string {")
+		assert.Contains(t, string(content), "\"StatusActive\":   `\"active\"`,")
+		assert.Contains(t, string(content), "Values are encoded as lower case names")
+	})
+
+	t.Run("json escaping", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		src := "package test\n\ntype role string\n\nconst (\n\troleAdmin role = \"r&d\"\n\troleTick role = \"a`b\"\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "role.go"), []byte(src), 0o600))
+		gen, err := New("role", "")
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(tmpDir))
+		gen.Path = tmpDir
+		gen.SetGenerateJSONExample(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "role_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\"RoleAdmin\": `\"r\\u0026d\"`,")
+		assert.Contains(t, string(content), "\"RoleTick\":  \"\\\"a`b\\\"\",")
+		assert.Contains(t, string(content), "Values are encoded as declared names")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "JSONExample")
+	})
+}
+
+func TestGenerateInfo(t *testing.T) {
+	tmpDir := t.TempDir()
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive            // enum:alias=running,on
+	jobStatusStarted           // enum:desc=Started by "cron" enum:deprecated
+)
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o600))
+	gen, err := New("jobStatus", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	gen.Path = tmpDir
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("info", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"type JobStatusValueDescriptor struct {\n\tName        string   `json:\"name\"`\n\tValue       uint8    `json:\"value\"`",
+		"func JobStatusInfo() []JobStatusValueDescriptor {",
+		`{Name: "unknown", Value: 0},`,
+		`{Name: "active", Value: 1, Aliases: []string{"running", "on"}},`,
+		`{Name: "started", Value: 2, Description: "Started by \"cron\"", Deprecated: true},`,
+		"func MarshalAllJobStatusJSON() ([]byte, error) {",
+		"\t\"encoding/json\"\n",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+}
+
+func TestGenerateImmutableValues(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("immutable", ""))
+	gen.SetWrap(true)
+	gen.SetGenerateTests(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"var _statusValues = []Status{\n\tStatusUnknown,\n",
+		"func StatusValues() []Status {\n\treturn append([]Status(nil), _statusValues...)\n}",
+		"return _statusValues[(i+1)%len(_statusValues)], true",
+		"for _, v := range _statusValues {",
+		"// returned only if e is not one of StatusValues.",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+	assert.NotContains(t, string(content), "var StatusValues")
+
+	tests, err := os.ReadFile(filepath.Join(tmpDir, "status_enum_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(tests), "for _, v := range _statusValues {")
+}
+
+func TestGenerateSeq(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("seq", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"\t\"iter\"\n",
+		"\t\"slices\"\n",
+		"func StatusAll() iter.Seq[Status] { return slices.Values(StatusValues) }",
+		"func StatusNamesSeq() iter.Seq[string] { return slices.Values(StatusNames) }",
+		"func StatusEntries() iter.Seq2[int, Status] { return slices.All(StatusValues) }",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+}
+
+func TestGenerateSorted(t *testing.T) {
+	tmpDir := t.TempDir()
+	src := `package test
+
+type priority int
+
+const (
+	priorityMedium priority = 5
+	priorityLow    priority = 1
+	priorityHigh   priority = 9
+	priorityNone   priority = 1
+)
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "priority.go"), []byte(src), 0o600))
+	gen, err := New("priority", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(tmpDir))
+	gen.Path = tmpDir
+	require.NoError(t, gen.SetOption("sorted", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "priority_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"func PriorityIterByValue() func(yield func(Priority) bool) {",
+		"func PriorityIterByName() func(yield func(Priority) bool) {",
+		"func PriorityIterReverse() func(yield func(Priority) bool) {",
+		"_priorityByValue = [...]Priority{PriorityLow, PriorityNone, PriorityMedium, PriorityHigh}",
+		"_priorityByName  = [...]Priority{PriorityHigh, PriorityLow, PriorityMedium, PriorityNone}",
+		"for i := len(PriorityValues) - 1; i >= 0; i-- {",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+}
+
+func TestGenerateDescriptor(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("descriptor", ""))
+	gen.SetGenerateInfo(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"type StatusEnumDescriptor struct{}",
+		"func StatusDescriptor() StatusEnumDescriptor { return StatusEnumDescriptor{} }",
+		`func (StatusEnumDescriptor) TypeName() string { return "Status" }`,
+		`func (StatusEnumDescriptor) UnderlyingType() string { return "uint8" }`,
+		"func (StatusEnumDescriptor) Len() int { return len(StatusValues) }",
+		"func (StatusEnumDescriptor) Value(i int) any { return StatusValues[i] }",
+		"func (StatusEnumDescriptor) Raw(i int) any { return StatusValues[i].Index() }",
+		"func (StatusEnumDescriptor) Aliases(i int) []string {",
+		"func (StatusEnumDescriptor) Parse(s string) (any, error) {",
+		"func StatusInfo() []StatusValueDescriptor {",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+	assert.NotContains(t, string(content), "registry.Register(")
+}
+
+func TestGenerateRegistry(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("registry", ""))
+	require.ErrorContains(t, gen.Generate(), "registry: failed to read go.mod")
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module example.com/app\n\ngo 1.24\n"), 0o600))
+	require.NoError(t, gen.Generate())
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "\t\"github.com/go-pkgz/enum/registry\"\n")
+	assert.Contains(t, string(content), "func StatusDescriptor() StatusEnumDescriptor {", "registry implies descriptor")
+	assert.Contains(t, string(content), `func init() { registry.Register("example.com/app.Status", StatusDescriptor()) }`)
+}
+
+func TestGenerateGuard(t *testing.T) {
+	generate := func(t *testing.T, opts ...string) string {
+		t.Helper()
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		for _, opt := range opts {
+			name, value, _ := strings.Cut(opt, "=")
+			require.NoError(t, gen.SetOption(name, value))
+		}
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	t.Run("default", func(t *testing.T) {
+		content := generate(t)
+		assert.Contains(t, content, "var _ = func() bool {")
+		assert.Contains(t, content, "\tvar _ status = statusActive\n")
+	})
+
+	t.Run("var", func(t *testing.T) {
+		content := generate(t, "guard=var")
+		assert.NotContains(t, content, "var _ = func() bool {")
+		assert.Contains(t, content, "var (\n\t_ = statusUnknown\n\t_ = statusActive\n\t_ = statusInactive\n\t_ = statusBlocked\n)\n")
+	})
+
+	t.Run("none", func(t *testing.T) {
+		content := generate(t, "guard=none")
+		assert.NotContains(t, content, "var _ = func() bool {")
+		assert.NotContains(t, content, "_ = statusActive")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetGuard("lambda")
+		require.EqualError(t, gen.Generate(), `invalid guard "lambda", expected func, var or none`)
+	})
+}
+
+func TestGenerateMarshalNumber(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetMarshalNumber(true)
+	gen.SetGenerateBSON(true)
+	gen.SetGenerateYAML(true)
+	gen.SetGenerateTOML(true)
+	gen.SetGenerateJSONExample(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	src := string(content)
+	assert.Contains(t, src, "return strconv.AppendInt(nil, int64(e.value), 10), nil")
+	assert.Contains(t, src, "func (e Status) MarshalJSON() ([]byte, error) {")
+	assert.Contains(t, src, "func (e *Status) UnmarshalJSON(data []byte) error {")
+	assert.Contains(t, src, "func _statusFromNumber(n int64) (Status, error) {")
+	assert.Contains(t, src, "return bson.MarshalValue(int64(e.value))")
+	assert.Contains(t, src, "return int64(e.value), nil")
+	assert.Contains(t, src, "value.Kind != unstable.String && value.Kind != unstable.Integer")
+	assert.Contains(t, src, "\"StatusActive\":   `1`,")
+	assert.Contains(t, src, `"encoding/json"`)
+	assert.Contains(t, src, `"strconv"`)
+	assert.NotContains(t, src, "return []byte(e.name), nil")
+
+	t.Run("string-backed", func(t *testing.T) {
+		dir := t.TempDir()
+		src := "package test\n\ntype role string\n\nconst roleAdmin role = \"admin\"\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "role.go"), []byte(src), 0o600))
+		gen, err := New("role", dir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(dir))
+		require.NoError(t, gen.SetOption("marshal-number", ""))
+		require.EqualError(t, gen.Generate(), "marshal-number is not supported for string-backed type role")
+	})
+}
+
+func TestGenerateParseMode(t *testing.T) {
+	generate := func(t *testing.T, mode string) string {
+		t.Helper()
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.SetOption("parse", mode))
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	t.Run("map", func(t *testing.T) {
+		content := generate(t, "map")
+		assert.Contains(t, content, "var _statusParseMap = map[string]Status{")
+		assert.NotContains(t, content, `"sync"`)
+		assert.Equal(t, content, generate(t, ""), "map is the default")
+	})
+
+	t.Run("lazy", func(t *testing.T) {
+		content := generate(t, "lazy")
+		assert.Contains(t, content, "var _statusParseMap = sync.OnceValue(func() map[string]Status {")
+		assert.Contains(t, content, "_statusParseMap()[strings.ToLower(v)]")
+		assert.Contains(t, content, `"sync"`)
+	})
+
+	t.Run("switch", func(t *testing.T) {
+		content := generate(t, "switch")
+		assert.NotContains(t, content, "_statusParseMap")
+		assert.NotContains(t, content, "strings.ToLower(v)")
+		assert.Contains(t, content, "switch v {\n\tcase \"unknown\", \"Unknown\":\n\t\treturn StatusUnknown, nil\n")
+		assert.Contains(t, content, "\tif len(v) <= 8 {\n\t\tvar buf [8]byte\n\t\tif _statusLowerASCII(v, buf[:len(v)]) {\n"+
+			"\t\t\tswitch string(buf[:len(v)]) {\n\t\t\tcase \"unknown\":\n\t\t\t\treturn StatusUnknown, nil\n")
+		assert.Contains(t, content, "\tswitch {\n\tcase strings.EqualFold(v, \"Unknown\"):\n\t\treturn StatusUnknown, nil\n",
+			"non-ASCII input is compared with all names")
+		assert.Contains(t, content, "func _statusLowerASCII(s string, buf []byte) bool {")
+		assert.Contains(t, content, `"unicode/utf8"`)
+	})
+
+	t.Run("switch with aliases", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		gen, err := New("permission", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata/integration"))
+		gen.SetParseMode("switch")
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(tmpDir, "permission_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\tcase \"readwrite\", \"ReadWrite\", \"rw\", \"read-write\":\n\t\treturn PermissionReadWrite, nil\n")
+		assert.Contains(t, string(content), "\t\t\tcase \"readwrite\", \"rw\", \"read-write\":\n\t\t\t\treturn PermissionReadWrite, nil\n")
+		assert.Contains(t, string(content), "\tcase strings.EqualFold(v, \"ReadWrite\") || strings.EqualFold(v, \"rw\") || "+
+			"strings.EqualFold(v, \"read-write\"):\n\t\treturn PermissionReadWrite, nil\n")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetParseMode("trie")
+		require.EqualError(t, gen.Generate(), `invalid parse mode "trie", expected map, lazy or switch`)
+	})
+}
+
+func TestParseCases(t *testing.T) {
+	values := []Value{
+		{PublicName: "StatusActive", Name: "Active", Aliases: []string{"on", "ACTIVE", "enabled"}},
+		{PublicName: "StatusBlocked", Name: "Blocked", Aliases: []string{"off"}},
+		{PublicName: "StatusNew", Name: "New"},
+	}
+	assert.Equal(t, []ParseCase{
+		{PublicName: "StatusActive", Names: []string{"Active", "on", "enabled"}},
+		{PublicName: "StatusBlocked", Names: []string{"Blocked", "off"}},
+		{PublicName: "StatusNew", Names: []string{"New"}},
+	}, parseCases(values))
+	assert.Equal(t, 7, parseMaxLen(values))
+
+	values[2].Aliases = []string{"\u212Aey-value"} // KELVIN SIGN is 3 bytes long, and its lower case "k" is 1 byte
+	assert.Equal(t, 9, parseMaxLen(values))
+}
+
+func TestGenerateTolerant(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("tolerant", ""))
+	gen.SetGenerateBSON(true)
+	gen.SetGenerateYAML(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	src := string(content)
+	assert.Contains(t, src, "return []byte(e.name), nil", "marshaled as name")
+	assert.NotContains(t, src, "func (e Status) MarshalJSON()")
+	assert.Contains(t, src, "// UnmarshalText implements encoding.TextUnmarshaler and decodes the enum from its name, numeric values are accepted as well")
+	assert.Contains(t, src, "func (e *Status) UnmarshalJSON(data []byte) error {")
+	assert.Contains(t, src, "func _statusFromNumber(n int64) (Status, error) {")
+	assert.Contains(t, src, "if t == bsontype.Int32 || t == bsontype.Int64 {")
+	assert.Contains(t, src, "return e.UnmarshalText([]byte(value.Value))")
+
+	t.Run("with marshal-number", func(t *testing.T) {
+		gen.SetMarshalNumber(true)
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func (e Status) MarshalJSON() ([]byte, error) {")
+		assert.Contains(t, string(content), "if t == bsontype.String {")
+	})
+
+	t.Run("string-backed", func(t *testing.T) {
+		dir := t.TempDir()
+		src := "package test\n\ntype role string\n\nconst roleAdmin role = \"admin\"\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "role.go"), []byte(src), 0o600))
+		gen, err := New("role", dir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(dir))
+		gen.SetTolerant(true)
+		require.EqualError(t, gen.Generate(), "tolerant is not supported for string-backed type role")
+	})
+}
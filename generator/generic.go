@@ -0,0 +1,17 @@
+package generator
+
+import "fmt"
+
+// SetGeneric enables or disables the generic core. Parsing, text marshaling and iteration are instantiations
+// of the generic helpers of the github.com/go-pkgz/enum/enumcore package over a table of the values built
+// once per type, e.g. enumcore.Parse(_statusTable, v), instead of code repeated for each type. The rest of
+// the generated code is the same. It's opt-in, the generated code depends on the enum module with it.
+func (g *Generator) SetGeneric(v bool) { g.generic = v }
+
+// checkGeneric reports options the generic core doesn't support
+func (g *Generator) checkGeneric() error {
+	if g.generic && g.parseMode != "" && g.parseMode != "map" {
+		return fmt.Errorf("generic can't be used with parse=%s, it needs the parse map", g.parseMode)
+	}
+	return nil
+}
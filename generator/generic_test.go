@@ -0,0 +1,104 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGeneric(t *testing.T) {
+	generate := func(t *testing.T, opts ...string) (string, error) {
+		t.Helper()
+		tmpDir := t.TempDir()
+		gen, err := New("status", tmpDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		require.NoError(t, gen.SetOption("generic", ""))
+		for _, opt := range opts {
+			require.NoError(t, gen.SetOption(opt, "true"))
+		}
+		if err := gen.Generate(); err != nil {
+			return "", err
+		}
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		return string(content), nil
+	}
+
+	content, err := generate(t, "lower")
+	require.NoError(t, err)
+	for _, want := range []string{
+		"\t\"github.com/go-pkgz/enum/enumcore\"\n",
+		"var _statusTable = enumcore.New(enumcore.Config[Status]{",
+		"\t\tStatusActive,\n",
+		"return enumcore.Parse(_statusTable, v)",
+		"return enumcore.ParseSlice(_statusTable, s, sep, ParseStatus)",
+		"func StatusJoin(values []Status, sep string) string { return enumcore.Join(values, sep) }",
+		"func (e Status) MarshalText() ([]byte, error) { return enumcore.MarshalText(_statusTable, e) }",
+		"func (e Status) Next() (Status, bool) { return enumcore.Next(_statusTable, e) }",
+		"func (e Status) Prev() (Status, bool) { return enumcore.Prev(_statusTable, e) }",
+		"var StatusValues = enumcore.Values(_statusTable)",
+		"var StatusNames = enumcore.Names(_statusTable)",
+		"return enumcore.Iter(_statusTable)",
+		"return enumcore.Ordinal(_statusTable, v)",
+		"func (e Status) EqualString(s string) bool {",
+	} {
+		assert.Contains(t, content, want)
+	}
+	for _, unwanted := range []string{"_statusParseMap", "_statusText", "\t\"errors\"\n", "Names: []string{"} {
+		assert.NotContains(t, content, unwanted, "the table is built by enumcore")
+	}
+
+	content, err = generate(t, "immutable")
+	require.NoError(t, err)
+	assert.Contains(t, content, "var _statusValues = enumcore.Values(_statusTable)")
+
+	_, err = generate(t, "runtime")
+	require.EqualError(t, err, "runtime can't be used with generic, the shared runtime doesn't support it")
+
+	t.Run("aliases and deprecated", func(t *testing.T) {
+		src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive // enum:alias=on
+	statusLegacy // enum:deprecated
+)
+`
+		srcDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+		outDir := t.TempDir()
+		gen, err := New("status", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGeneric(true)
+		gen.SetHideDeprecated(true)
+		gen.SetWrap(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `	Aliases: map[string]Status{
+		"on": StatusActive,
+	},
+	Hidden: []Status{
+		StatusLegacy,
+	},
+	Wrap: true,
+})`)
+	})
+
+	t.Run("parse mode", func(t *testing.T) {
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetGeneric(true)
+		require.NoError(t, gen.SetOption("parse", "switch"))
+		assert.EqualError(t, gen.Generate(), "generic can't be used with parse=switch, it needs the parse map")
+	})
+}
@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// SetGenerateGraphQL enables or disables generation of MarshalGQL and UnmarshalGQL, to bind the enum
+// to a GraphQL schema enum with gqlgen. Values are named in SCREAMING_SNAKE_CASE, e.g. IN_PROGRESS.
+func (g *Generator) SetGenerateGraphQL(v bool) { g.generateGraphQL = v }
+
+// SetGenerateGQLGenConfig enables or disables writing the gqlgen.yml models stanza binding the schema enum
+// to the type, and the schema enum declaration as a comment, to <type>_enum.gqlgen.yml
+func (g *Generator) SetGenerateGQLGenConfig(v bool) { g.generateGQLGen = v }
+
+// getGQLGenFileName returns the gqlgen config file name for the generated file, e.g. "job_status_enum.gqlgen.yml"
+func getGQLGenFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".gqlgen.yml"
+}
+
+// setGraphQLNames sets GraphQL names of the values, the public name without the type prefix in
+// SCREAMING_SNAKE_CASE, and checks they are valid and distinct
+func setGraphQLNames(typeName string, values []Value) error {
+	var errs []error
+	seen := make(map[string]string, len(values)) // GraphQL name to private name
+	for i, v := range values {
+		name := screamingSnake(strings.TrimPrefix(v.PublicName, titleCaser.String(typeName)))
+		switch {
+		case name == "" || name[0] >= '0' && name[0] <= '9':
+			errs = append(errs, fmt.Errorf("graphql name %q of %s must start with a letter", name, v.PrivateName))
+		case seen[name] != "":
+			errs = append(errs, fmt.Errorf("graphql name %s of %s is the same as of %s", name, v.PrivateName, seen[name]))
+		}
+		seen[name] = v.PrivateName
+		values[i].GraphQLName = name
+	}
+	return errors.Join(errs...)
+}
+
+// renderGQLGenConfig returns the models stanza of gqlgen.yml binding the GraphQL enum of the same name to
+// the type in the package with the import path, and the schema declaration of the enum as a comment
+func renderGQLGenConfig(data TemplateData, importPath string) []byte {
+	typeName := data.GoType
+	var sb strings.Builder
+	sb.WriteString(openAPIHeader + "\n") // the same header as OpenAPI schemas, to be recognized as generated
+	sb.WriteString("# Merge into gqlgen.yml, the schema declares the enum as:\n#\n")
+	fmt.Fprintf(&sb, "#   enum %s {\n", typeName)
+	for _, v := range data.Values {
+		fmt.Fprintf(&sb, "#     %s", v.GraphQLName)
+		if v.Deprecated {
+			fmt.Fprintf(&sb, " @deprecated(reason: %s)", strconv.Quote(cmp.Or(v.Deprecation, "No longer supported")))
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("#   }\n")
+	sb.WriteString("models:\n")
+	fmt.Fprintf(&sb, "  %s:\n", typeName)
+	fmt.Fprintf(&sb, "    model: %s.%s\n", importPath, typeName)
+	return []byte(sb.String())
+}
+
+// packageImportPath returns the import path of the package in the directory, from the module path in
+// the closest go.mod and the directory relative to it
+func packageImportPath(dir string) (string, error) {
+	root := ModuleRoot(dir)
+	data, err := os.ReadFile(filepath.Join(root, "go.mod")) //nolint:gosec // go.mod of the generated package
+	if err != nil {
+		return "", fmt.Errorf("failed to read go.mod of %s: %w", dir, err)
+	}
+	var module string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module"); ok && rest != "" {
+			module = strings.Trim(strings.TrimSpace(rest), `"`)
+			break
+		}
+	}
+	if module == "" {
+		return "", fmt.Errorf("no module path in %s", filepath.Join(root, "go.mod"))
+	}
+
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", dir, err)
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", dir, err)
+	}
+	return path.Join(module, filepath.ToSlash(rel)), nil
+}
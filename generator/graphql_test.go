@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGraphQL(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusInProgress
+	jobStatusLegacy // enum:deprecated=use InProgress
+)
+`
+	srcDir, root := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/app\n\ngo 1.23\n"), 0o600))
+	outDir := filepath.Join(root, "internal", "api")
+
+	gen, err := New("jobStatus", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("graphql", ""))
+	require.NoError(t, gen.SetOption("gqlgen", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e JobStatus) MarshalGQL(w io.Writer) {")
+	assert.Contains(t, string(content), "func (e *JobStatus) UnmarshalGQL(v any) error {")
+	assert.Contains(t, string(content), `var _jobStatusGraphQLNames = map[JobStatus]string{
+	JobStatusUnknown:    "UNKNOWN",
+	JobStatusInProgress: "IN_PROGRESS",
+	JobStatusLegacy:     "LEGACY",
+}`)
+	assert.Contains(t, string(content), `JobStatusInProgress = JobStatus{name: "inprogress", value: 1}`, "string form is kept")
+
+	config, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.gqlgen.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, `# Code generated by enum generator; DO NOT EDIT.
+# Merge into gqlgen.yml, the schema declares the enum as:
+#
+#   enum JobStatus {
+#     UNKNOWN
+#     IN_PROGRESS
+#     LEGACY @deprecated(reason: "use InProgress")
+#   }
+models:
+  JobStatus:
+    model: example.com/app/internal/api.JobStatus
+`, string(config))
+
+	t.Run("disabled", func(t *testing.T) {
+		gen.SetGenerateGraphQL(false)
+		gen.SetGenerateGQLGenConfig(false)
+		require.NoError(t, gen.Generate())
+		assert.NoFileExists(t, filepath.Join(outDir, "job_status_enum.gqlgen.yml"), "stale config is removed")
+		content, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "GQL")
+		assert.NotContains(t, string(content), `"io"`)
+	})
+
+	t.Run("invalid names", func(t *testing.T) {
+		src := `package test
+
+type status uint8
+
+const (
+	statusInProgress status = iota
+	statusIn_Progress
+	status2FA
+)
+`
+		srcDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetGenerateGraphQL(true)
+		err = gen.Generate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "graphql name IN_PROGRESS of statusIn_Progress is the same as of statusInProgress")
+		assert.Contains(t, err.Error(), `graphql name "2FA" of status2FA must start with a letter`)
+	})
+
+	t.Run("no module", func(t *testing.T) {
+		_, err := packageImportPath(t.TempDir())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to read go.mod")
+	})
+}
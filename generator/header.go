@@ -0,0 +1,77 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+// SetHeader sets the file with a header, e.g. a license banner, prepended to generated Go files before the
+// generated code comment. The file is a text/template with .Version of the generator, .Type, .Year and
+// .Timestamp, taken from SOURCE_DATE_EPOCH if set for reproducible output. Lines are turned into comments
+// unless they are already. The file is read on Generate.
+func (g *Generator) SetHeader(path string) { g.header = path }
+
+// headerData is the data of the header template
+type headerData struct {
+	Version   string // version of the generator, empty for development builds
+	Type      string // type name, names of all types separated by commas in combined files
+	Year      int    // year of the generation
+	Timestamp string // time of the generation in RFC 3339 format, UTC
+}
+
+// renderHeader returns the header of generated Go files for the types, followed by an empty line,
+// or nil if no header is set
+func (g *Generator) renderHeader(typeName string) ([]byte, error) {
+	if g.header == "" {
+		return nil, nil
+	}
+	text, err := os.ReadFile(g.header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+	tmpl, err := template.New("header").Parse(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse header %s: %w", g.header, err)
+	}
+
+	now := time.Now().UTC()
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); epoch != "" {
+		sec, perr := strconv.ParseInt(epoch, 10, 64)
+		if perr != nil {
+			return nil, fmt.Errorf("invalid SOURCE_DATE_EPOCH %q: %w", epoch, perr)
+		}
+		now = time.Unix(sec, 0).UTC()
+	}
+	version := g.version
+	if !semver.IsValid(version) {
+		version = "" // development build
+	}
+
+	var buf bytes.Buffer
+	data := headerData{Version: version, Type: typeName, Year: now.Year(), Timestamp: now.Format(time.RFC3339)}
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute header %s: %w", g.header, err)
+	}
+
+	var res bytes.Buffer
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		switch {
+		case line == "":
+			res.WriteString("//\n")
+		case strings.HasPrefix(line, "//"):
+			res.WriteString(line + "\n")
+		default:
+			res.WriteString("// " + line + "\n")
+		}
+	}
+	res.WriteString("\n")
+	return res.Bytes(), nil
+}
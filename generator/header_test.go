@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateHeader(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	header := filepath.Join(t.TempDir(), "header.txt")
+	require.NoError(t, os.WriteFile(header, []byte("Copyright {{.Year}} Example Corp.\n\n"+
+		"// SPDX-License-Identifier: Apache-2.0\nType {{.Type}}{{with .Version}}, generator {{.}}{{end}}, {{.Timestamp}}\n"), 0o600))
+	banner := "// Copyright 2023 Example Corp.\n//\n// SPDX-License-Identifier: Apache-2.0\n" +
+		"// Type status, generator v1.3.0, 2023-11-14T22:13:20Z\n\n"
+
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetVersion("v1.3.0")
+	gen.SetGenerateTests(true)
+	require.NoError(t, gen.SetOption("header", header))
+	require.NoError(t, gen.Generate())
+
+	for _, name := range []string{"status_enum.go", "status_enum_test.go"} {
+		content, err := os.ReadFile(filepath.Join(tmpDir, name))
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(content), banner+generatedHeader+"\n"), string(content))
+		assert.True(t, isGeneratedFile(content), "file with a header is recognized as generated")
+	}
+	stale, err := gen.Check()
+	require.NoError(t, err)
+	assert.Empty(t, stale, "header is reproducible with SOURCE_DATE_EPOCH")
+
+	gen.SetGenerateTests(false)
+	require.NoError(t, gen.Generate())
+	assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum_test.go"), "stale file with a header is removed")
+
+	t.Run("development build", func(t *testing.T) {
+		gen.SetVersion("(devel)")
+		data, err := gen.renderHeader("status")
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "// Type status, 2023-11-14T22:13:20Z\n")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		gen.SetHeader(filepath.Join(t.TempDir(), "missing.txt"))
+		assert.ErrorContains(t, gen.Generate(), "failed to read header")
+
+		invalid := filepath.Join(t.TempDir(), "invalid.txt")
+		require.NoError(t, os.WriteFile(invalid, []byte("{{.Year"), 0o600))
+		gen.SetHeader(invalid)
+		assert.ErrorContains(t, gen.Generate(), "failed to parse header")
+
+		gen.SetHeader(header)
+		t.Setenv("SOURCE_DATE_EPOCH", "yesterday")
+		assert.ErrorContains(t, gen.Generate(), `invalid SOURCE_DATE_EPOCH "yesterday"`)
+	})
+}
+
+func TestIsGeneratedFileWithHeader(t *testing.T) {
+	assert.True(t, isGeneratedFile([]byte("// Copyright\n\n"+generatedHeader+"\npackage x\n")))
+	assert.False(t, isGeneratedFile([]byte("// Copyright\npackage x\n\n"+generatedHeader+"\n")), "only before the package clause")
+	assert.False(t, isGeneratedFile([]byte("// Copyright\n\npackage x\n")))
+}
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Translation is the table of localized names of one locale
+type Translation struct {
+	Locale string            // normalized locale, lowercase with dashes, e.g. "pt-br"
+	Names  []TranslatedValue // localized names in declaration order of the values
+}
+
+// TranslatedValue is the localized name of a value
+type TranslatedValue struct {
+	PublicName string // public constant name, e.g. "StatusActive"
+	Name       string // localized name, e.g. "Aktiv"
+}
+
+// SetTranslations sets the translations file, JSON or YAML keyed by locale and then by value name, e.g.
+//
+//	de:
+//	  active: Aktiv
+//	  blocked: Gesperrt
+//
+// Values are given by names, as in String, or by private or public constant names. The file is read on Generate.
+func (g *Generator) SetTranslations(path string) { g.translations = path }
+
+// buildTranslations reads the translations file and resolves its value names, locales are sorted
+func (g *Generator) buildTranslations(values []Value) ([]Translation, error) {
+	if g.translations == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(g.translations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read translations: %w", err)
+	}
+	var raw map[string]map[string]string
+	switch strings.ToLower(filepath.Ext(g.translations)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	default:
+		err = json.Unmarshal(data, &raw)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse translations %s: %w", g.translations, err)
+	}
+
+	var errs []error
+	locales := make(map[string]string, len(raw)) // normalized to original locale
+	res := make([]Translation, 0, len(raw))
+	for _, locale := range sortedKeys(raw) {
+		norm := normalizeLocale(locale)
+		if norm == "" || strings.Trim(norm, "abcdefghijklmnopqrstuvwxyz0123456789-") != "" {
+			errs = append(errs, fmt.Errorf("invalid locale %q, expected tag like de or pt-BR", locale))
+			continue
+		}
+		if other, ok := locales[norm]; ok {
+			errs = append(errs, fmt.Errorf("duplicate locale %q: same as %q", locale, other))
+			continue
+		}
+		locales[norm] = locale
+
+		names := make(map[string]string, len(raw[locale])) // public constant name to localized name
+		for _, key := range sortedKeys(raw[locale]) {
+			name := raw[locale][key]
+			idx := slices.IndexFunc(values, func(v Value) bool {
+				return strings.EqualFold(v.Name, key) || v.PrivateName == g.privateValueName(key) || v.PublicName == key
+			})
+			if idx < 0 {
+				errs = append(errs, fmt.Errorf("locale %s: unknown value %s of type %s", locale, key, g.Type))
+				continue
+			}
+			if _, ok := names[values[idx].PublicName]; ok {
+				errs = append(errs, fmt.Errorf("locale %s: duplicate translation of %s", locale, values[idx].PrivateName))
+				continue
+			}
+			names[values[idx].PublicName] = name
+		}
+
+		tr := Translation{Locale: norm}
+		for _, v := range values {
+			if name, ok := names[v.PublicName]; ok {
+				tr.Names = append(tr.Names, TranslatedValue{PublicName: v.PublicName, Name: name})
+			}
+		}
+		res = append(res, tr)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid translations %s: %w", g.translations, errors.Join(errs...))
+	}
+	slices.SortFunc(res, func(a, b Translation) int { return strings.Compare(a.Locale, b.Locale) })
+	return res, nil
+}
+
+// normalizeLocale lowercases the locale and replaces underscores with dashes, e.g. "pt_BR" becomes "pt-br".
+// The generated Localized method normalizes the requested language the same way.
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(locale)), "_", "-")
+}
@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTranslations(t *testing.T) {
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "status.yaml")
+	require.NoError(t, os.WriteFile(yamlFile, []byte(`
+de:
+  active: Aktiv
+  StatusBlocked: Gesperrt
+  statusUnknown: Unbekannt
+pt_BR:
+  ACTIVE: "Ativo \"sim\""
+`), 0o600))
+
+	gen, err := New("status", dir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("translations", yamlFile))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(dir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e Status) Localized(lang string) string {")
+	assert.Contains(t, string(content), `var StatusTranslations = map[string]map[Status]string{
+	"de": {
+		StatusUnknown: "Unbekannt",
+		StatusActive:  "Aktiv",
+		StatusBlocked: "Gesperrt",
+	},
+	"pt-br": {
+		StatusActive: "Ativo \"sim\"",
+	},
+}`)
+
+	t.Run("json", func(t *testing.T) {
+		jsonFile := filepath.Join(dir, "status.json")
+		require.NoError(t, os.WriteFile(jsonFile, []byte(`{"fr": {"inactive": "Inactif"}}`), 0o600))
+		gen.SetTranslations(jsonFile)
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(dir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\t\"fr\": {\n\t\tStatusInactive: \"Inactif\",\n\t},\n")
+	})
+
+	t.Run("no translations", func(t *testing.T) {
+		gen.SetTranslations("")
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(dir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "Localized")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name, file, content, err string
+		}{
+			{"missing", "missing.json", "", "failed to read translations"},
+			{"bad yaml", "bad.yaml", "de: [", "failed to parse translations"},
+			{"unknown value", "unknown.json", `{"de": {"deleted": "Geloescht"}}`, "locale de: unknown value deleted of type status"},
+			{"invalid locale", "locale.json", `{"de ch": {"active": "Aktiv"}}`, `invalid locale "de ch"`},
+			{"duplicate locale", "dup.json", `{"pt_BR": {"active": "Ativo"}, "pt-br": {"active": "Ativo"}}`,
+				`duplicate locale "pt_BR": same as "pt-br"`},
+			{"duplicate value", "dupvalue.json", `{"de": {"active": "Aktiv", "StatusActive": "Aktiv"}}`,
+				"locale de: duplicate translation of statusActive"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), tt.file)
+				if tt.content != "" {
+					require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+				}
+				gen.SetTranslations(path)
+				err := gen.Generate()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+			})
+		}
+	})
+}
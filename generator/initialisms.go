@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SetInitialisms sets domain initialisms, e.g. SKU, VAT and IBAN, recognized in type and constant names
+// in any case. They are words of their own in file and database names, e.g. "job_skus_enum.go" for jobSKUs,
+// and are upper-cased in exported names, e.g. SKUStatus and SKUStatusVATExempt for skuStatus and
+// skuStatusVatExempt. String representations of the values are not affected.
+func (g *Generator) SetInitialisms(initialisms []string) {
+	g.initialisms = nil
+	g.addInitialisms(strings.Join(initialisms, ","))
+}
+
+// addInitialisms adds comma or space separated initialisms to the ones already set
+func (g *Generator) addInitialisms(list string) {
+	for _, s := range strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if s = strings.ToUpper(s); !slices.Contains(g.initialisms, s) {
+			g.initialisms = append(g.initialisms, s)
+		}
+	}
+	// longer ones first, so SKUS is matched before SKU
+	slices.SortStableFunc(g.initialisms, func(a, b string) int { return len(b) - len(a) })
+}
+
+// splitWords splits the camel case name into words like splitCamelCase, with the initialisms as words
+// of their own, upper-cased, followed by a lowercase "s" for plurals
+func (g *Generator) splitWords(s string) []string {
+	if len(g.initialisms) == 0 {
+		return splitCamelCase(s)
+	}
+	var words []string
+	start := 0 // start of the part not matched to initialisms yet
+	for i := 0; i < len(s); {
+		r, width := utf8.DecodeRuneInString(s[i:])
+		prev, _ := utf8.DecodeLastRuneInString(s[:i])
+		if i > 0 && !unicode.IsUpper(r) && unicode.IsLetter(prev) {
+			i += width
+			continue // initialisms start a word
+		}
+		word, n := g.matchInitialism(s[i:])
+		if n == 0 {
+			i += width
+			continue
+		}
+		if start < i {
+			words = append(words, splitCamelCase(s[start:i])...)
+		}
+		words = append(words, word)
+		i += n
+		start = i
+	}
+	if start < len(s) {
+		words = append(words, splitCamelCase(s[start:])...)
+	}
+	return words
+}
+
+// matchInitialism returns the initialism s starts with, case-insensitively, upper-cased and followed by "s"
+// for plurals, with the length of the match, zero if s doesn't start with one. The initialism must end
+// the word, followed by the end of s, a digit or an upper-case letter.
+func (g *Generator) matchInitialism(s string) (word string, n int) {
+	for _, in := range g.initialisms {
+		if len(s) < len(in) || !strings.EqualFold(s[:len(in)], in) {
+			continue
+		}
+		rest := s[len(in):]
+		if strings.HasPrefix(rest, "s") && endsWord(rest[1:]) {
+			return in + "s", len(in) + 1
+		}
+		if endsWord(rest) {
+			return in, len(in)
+		}
+	}
+	return "", 0
+}
+
+// endsWord reports whether s is empty or starts a new word, with a digit or an upper-case letter
+func endsWord(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return s == "" || unicode.IsDigit(r) || unicode.IsUpper(r)
+}
+
+// snakeName returns the name in snake case, split into words with the initialisms, e.g. "job_skus" for jobSKUs
+func (g *Generator) snakeName(s string) string {
+	words := g.splitWords(s)
+	for i := range words {
+		words[i] = strings.ToLower(words[i])
+	}
+	return strings.Join(words, "_")
+}
+
+// exportedName returns the name title-cased, with the initialisms upper-cased, e.g. "SKUStatusVATExempt"
+// for skuStatusVatExempt. Without initialisms it's title-cased only.
+func (g *Generator) exportedName(s string) string {
+	if len(g.initialisms) == 0 {
+		return titleCaser.String(s)
+	}
+	words := g.splitWords(s)
+	words[0] = titleCaser.String(words[0])
+	return strings.Join(words, "")
+}
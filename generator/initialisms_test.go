@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialisms(t *testing.T) {
+	g := &Generator{}
+	g.SetInitialisms([]string{"sku", "VAT", "iban", "Sku"})
+	assert.Equal(t, []string{"IBAN", "SKU", "VAT"}, g.initialisms, "longer first, without duplicates")
+
+	tests := []struct {
+		input    string
+		words    []string
+		snake    string
+		exported string
+	}{
+		{"jobSKUStatus", []string{"job", "SKU", "Status"}, "job_sku_status", "JobSKUStatus"},
+		{"jobSkuStatus", []string{"job", "SKU", "Status"}, "job_sku_status", "JobSKUStatus"},
+		{"skuVatExempt", []string{"SKU", "VAT", "Exempt"}, "sku_vat_exempt", "SKUVATExempt"},
+		{"jobVATSKU", []string{"job", "VAT", "SKU"}, "job_vat_sku", "JobVATSKU"},
+		{"jobSKUs", []string{"job", "SKUs"}, "job_skus", "JobSKUs"},
+		{"ibanCode2", []string{"IBAN", "Code2"}, "iban_code2", "IBANCode2"},
+		{"vatican", []string{"vatican"}, "vatican", "Vatican"},
+		{"jobVatican", []string{"job", "Vatican"}, "job_vatican", "JobVatican"},
+		{"internalIPAddress", []string{"internal", "IP", "Address"}, "internal_ip_address", "InternalIPAddress"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.words, g.splitWords(tt.input))
+			assert.Equal(t, tt.snake, g.snakeName(tt.input))
+			assert.Equal(t, tt.exported, g.exportedName(tt.input))
+		})
+	}
+
+	t.Run("no initialisms", func(t *testing.T) {
+		g := &Generator{}
+		assert.Equal(t, []string{"job", "SK", "Us"}, g.splitWords("jobSKUs"))
+		assert.Equal(t, "JobSkuStatus", g.exportedName("jobSkuStatus"))
+	})
+}
+
+func TestGenerateInitialisms(t *testing.T) {
+	src := `package test
+
+//enum: initialisms=sku,vat
+type jobSkuStatus uint8
+
+const (
+	jobSkuStatusUnknown jobSkuStatus = iota
+	jobSkuStatusVatExempt
+)
+`
+	srcDir := filepath.Join(t.TempDir(), "test")
+	require.NoError(t, os.Mkdir(srcDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "go.mod"), []byte("module example.com/test\n\ngo 1.23\n"), 0o600))
+
+	gen, err := New("jobSkuStatus", srcDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetGenerateSQLC(true)
+	gen.SetGenerateTests(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(srcDir, "job_sku_status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"type JobSKUStatus struct {",
+		"func ParseJobSKUStatus(v string) (JobSKUStatus, error) {",
+		"var JobSKUStatusValues = []JobSKUStatus{",
+		`JobSKUStatusVATExempt = JobSKUStatus{name: "VatExempt", value: 1}`,
+		`fmt.Sprintf("JobSKUStatus{name: %q, value: %d}", e.name, e.value)`,
+	} {
+		assert.Contains(t, string(content), want)
+	}
+	assert.NotContains(t, string(content), "type JobSkuStatus ")
+	tests, err := os.ReadFile(filepath.Join(srcDir, "job_sku_status_enum_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(tests), "got, err := ParseJobSKUStatus(v.String())")
+
+	sqlc, err := os.ReadFile(filepath.Join(srcDir, "job_sku_status_enum.sqlc.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(sqlc), "  - db_type: job_sku_status\n")
+	assert.Contains(t, string(sqlc), "      type: JobSKUStatus\n")
+}
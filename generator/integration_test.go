@@ -33,6 +33,7 @@ func TestGenerateEnumWithAllFeatures(t *testing.T) {
 	gen.SetGenerateBSON(true)
 	gen.SetGenerateSQL(true)
 	gen.SetGenerateYAML(true)
+	gen.SetGenerateJSONMap(true)
 
 	err = gen.Parse(testDir)
 	require.NoError(t, err)
@@ -55,6 +56,7 @@ func TestGenerateEnumWithAllFeatures(t *testing.T) {
 	assert.Contains(t, string(content), "Scan(value interface{})")
 	assert.Contains(t, string(content), "MarshalYAML()")
 	assert.Contains(t, string(content), "UnmarshalYAML(")
+	assert.Contains(t, string(content), "func MarshalStatusMapJSON[V any](m map[Status]V) ([]byte, error) {")
 
 	// don't cleanup - we need the generated file for integration tests
 }
@@ -425,7 +427,7 @@ func TestRuntimeIntegration(t *testing.T) {
 
 	// 3. Generate enums using the built binary
 	// generate status enum
-	cmd = exec.Command(binPath, "-type=status", "-lower", "-sql", "-bson", "-yaml")
+	cmd = exec.Command(binPath, "-type=status", "-lower", "-sql", "-bson", "-yaml", "-jsonmap")
 	cmd.Dir = pkgDir
 	output, err = cmd.CombinedOutput()
 	require.NoError(t, err, "failed to generate status enum: %s", output)
@@ -489,6 +491,7 @@ require (
 	require.Contains(t, outputStr, "TestGeneratedEnumWithSQL")
 	require.Contains(t, outputStr, "TestGeneratedEnumWithYAML")
 	require.Contains(t, outputStr, "TestGeneratedEnumWithJSON")
+	require.Contains(t, outputStr, "TestGeneratedEnumAsJSONMapKey")
 }
 
 // TestRuntimeIntegrationErrors tests error cases in the generation pipeline
@@ -0,0 +1,187 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// SetExistingMethods sets what happens if methods of the generated types are already declared in the package,
+// e.g. a hand-written String or MarshalJSON: "fail" to fail with the list of them, or "skip" to leave the
+// generated ones out. An empty mode means the default, "fail".
+func (g *Generator) SetExistingMethods(mode string) { g.existingMethods = mode }
+
+// methodPositions returns methods declared in the file as "Type.Method", with the file and line of each
+func methodPositions(fset *token.FileSet, file *ast.File) map[string]string {
+	res := make(map[string]string)
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil {
+			continue
+		}
+		if recv := receiverName(fn.Recv); recv != "" {
+			pos := fset.Position(fn.Pos())
+			res[recv+"."+fn.Name.Name] = fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line)
+		}
+	}
+	return res
+}
+
+// receiverName returns the type name of the method receiver, without the pointer and type parameters
+func receiverName(recv *ast.FieldList) string {
+	if len(recv.List) == 0 {
+		return ""
+	}
+	expr := recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch e := expr.(type) {
+	case *ast.IndexExpr:
+		expr = e.X
+	case *ast.IndexListExpr:
+		expr = e.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// skipExistingMethods checks methods of the generated Go sources against methods declared in the package
+// by hand. With skip set, the generated methods are removed, along with imports used only by them,
+// otherwise an error lists all of them. Generated code of another package can't collide.
+func (g *Generator) skipExistingMethods(files []generatedFile, skip bool) error {
+	if len(g.declaredMethods) == 0 || g.crossPackage {
+		return nil
+	}
+	var errs []error
+	for i, f := range files {
+		if !strings.HasSuffix(f.name, ".go") {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f.name, f.src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("failed to parse generated source: %w", err)
+		}
+		var removed []ast.Decl
+		decls := file.Decls[:0]
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil {
+				decls = append(decls, decl)
+				continue
+			}
+			method := receiverName(fn.Recv) + "." + fn.Name.Name
+			pos, declared := g.declaredMethods[method]
+			switch {
+			case !declared:
+				decls = append(decls, decl)
+			case skip:
+				removed = append(removed, decl)
+			default:
+				errs = append(errs, fmt.Errorf("method %s is already declared at %s, remove it or skip it "+
+					"with -existing-methods skip or //enum: existing-methods=skip", method, pos))
+			}
+		}
+		if len(removed) == 0 {
+			continue
+		}
+		file.Decls = decls
+		file.Comments = commentsOutside(file.Comments, removed)
+		removeUnusedImports(file)
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, file); err != nil {
+			return fmt.Errorf("failed to format source: %w", err)
+		}
+		files[i].src = buf.Bytes()
+	}
+	return errors.Join(errs...)
+}
+
+// commentsOutside returns comment groups not inside any of the declarations, doc comments included
+func commentsOutside(comments []*ast.CommentGroup, decls []ast.Decl) []*ast.CommentGroup {
+	res := comments[:0]
+	for _, cg := range comments {
+		inside := false
+		for _, decl := range decls {
+			start := decl.Pos()
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Doc != nil {
+				start = fn.Doc.Pos()
+			}
+			if cg.Pos() >= start && cg.End() <= decl.End() {
+				inside = true
+				break
+			}
+		}
+		if !inside {
+			res = append(res, cg)
+		}
+	}
+	return res
+}
+
+// removeUnusedImports removes named and default imports no selector of the file refers to any more
+func removeUnusedImports(file *ast.File) {
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+	isUsed := func(spec *ast.ImportSpec) bool {
+		if spec.Name != nil {
+			return spec.Name.Name == "_" || spec.Name.Name == "." || used[spec.Name.Name]
+		}
+		path, err := strconv.Unquote(spec.Path.Value)
+		return err != nil || used[importName(path)]
+	}
+
+	imports := file.Imports[:0]
+	for _, decl := range file.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.IMPORT {
+			continue
+		}
+		specs := gd.Specs[:0]
+		for _, spec := range gd.Specs {
+			if spec := spec.(*ast.ImportSpec); isUsed(spec) {
+				specs = append(specs, spec)
+				imports = append(imports, spec)
+			}
+		}
+		gd.Specs = specs
+	}
+	file.Imports = imports
+	decls := file.Decls[:0]
+	for _, decl := range file.Decls {
+		if gd, ok := decl.(*ast.GenDecl); ok && gd.Tok == token.IMPORT && len(gd.Specs) == 0 {
+			continue
+		}
+		decls = append(decls, decl)
+	}
+	file.Decls = decls
+}
+
+// importName returns the default name of the imported package, the last element of the path without
+// the major version, e.g. "yaml" for gopkg.in/yaml.v3 and "pgx" for github.com/jackc/pgx/v5
+func importName(path string) string {
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = elems[len(elems)-2]
+	}
+	name, _, _ = strings.Cut(name, ".")
+	return name
+}
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportName(t *testing.T) {
+	for path, want := range map[string]string{
+		"fmt":                     "fmt",
+		"database/sql/driver":     "driver",
+		"gopkg.in/yaml.v3":        "yaml",
+		"github.com/jackc/pgx/v5": "pgx",
+	} {
+		assert.Equal(t, want, importName(path), path)
+	}
+}
+
+func TestGenerateExistingMethods(t *testing.T) {
+	src := `package test
+
+import "strings"
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+
+// String returns the name in upper case
+func (s Status) String() string { return strings.ToUpper(s.name) }
+
+func (s *Status) Scan(any) error { return nil }
+
+func (s *NullStatus) Validate() error { return nil }
+`
+	newGen := func(t *testing.T, mode string) (*Generator, string) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(src), 0o600))
+		gen, err := New("status", dir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(dir))
+		require.NoError(t, gen.SetOption("existing-methods", mode))
+		gen.SetGenerateSQL(true)
+		return gen, dir
+	}
+
+	t.Run("fail", func(t *testing.T) {
+		gen, _ := newGen(t, "")
+		assert.EqualError(t, gen.Generate(),
+			"method Status.String is already declared at status.go:13, remove it or skip it with -existing-methods skip or //enum: existing-methods=skip\n"+
+				"method Status.Scan is already declared at status.go:15, remove it or skip it with -existing-methods skip or //enum: existing-methods=skip")
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		gen, dir := newGen(t, "skip")
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(dir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "func (e Status) String() string")
+		assert.NotContains(t, string(content), "String returns")
+		assert.NotContains(t, string(content), "func (e *Status) Scan(")
+		assert.Contains(t, string(content), "func (e Status) Value() (driver.Value, error)")
+		assert.Contains(t, string(content), "func (e *Status) UnmarshalText(")
+	})
+
+	t.Run("imports", func(t *testing.T) {
+		file := `package test
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (e Status) String() string { return fmt.Sprint(e.name) }
+
+func (e Status) Upper() string { return strings.ToUpper(e.name) }
+`
+		gen := &Generator{declaredMethods: map[string]string{"Status.String": "status.go:3"}}
+		files := []generatedFile{{name: "status_enum.go", src: []byte(file)}}
+		require.NoError(t, gen.skipExistingMethods(files, true))
+		assert.Equal(t, "package test\n\nimport (\n\t\"strings\"\n)\n\n"+
+			"func (e Status) Upper() string { return strings.ToUpper(e.name) }\n", string(files[0].src))
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		gen, _ := newGen(t, "rename")
+		assert.EqualError(t, gen.Generate(), `invalid existing methods mode "rename", expected fail or skip`)
+	})
+}
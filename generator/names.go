@@ -0,0 +1,251 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SetName sets the name of a generated package-level identifier, e.g. SetName("ParseStatus", "DecodeStatus"),
+// for names colliding with symbols already declared in the package. The name is used as is, prefixes,
+// suffixes and unexporting don't apply to it.
+func (g *Generator) SetName(name, newName string) {
+	if g.names == nil {
+		g.names = make(map[string]string)
+	}
+	g.names[name] = newName
+}
+
+// SetParseName sets the name of the ParseStatus function, see SetName
+func (g *Generator) SetParseName(name string) { g.SetName("Parse"+titleCaser.String(g.Type), name) }
+
+// SetValuesName sets the name of the StatusValues variable, see SetName
+func (g *Generator) SetValuesName(name string) { g.SetName(titleCaser.String(g.Type)+"Values", name) }
+
+// SetNamePrefix sets the prefix added to generated package-level names, except for the type and the values,
+// e.g. "Enum" for EnumParseStatus and EnumStatusValues
+func (g *Generator) SetNamePrefix(prefix string) { g.namePrefix = prefix }
+
+// SetNameSuffix sets the suffix added to generated package-level names, except for the type and the values,
+// e.g. "Gen" for ParseStatusGen and StatusValuesGen
+func (g *Generator) SetNameSuffix(suffix string) { g.nameSuffix = suffix }
+
+// setNameOption sets the name of a generated identifier from an Old:New option value
+func (g *Generator) setNameOption(value string) error {
+	name, newName, ok := strings.Cut(value, ":")
+	if !ok || name == "" || newName == "" {
+		return fmt.Errorf("invalid name %q, expected Old:New", value)
+	}
+	g.SetName(name, newName)
+	return nil
+}
+
+// declaredNames returns names of package-level declarations of the file, methods excluded
+func declaredNames(file *ast.File) []string {
+	var res []string
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				res = append(res, decl.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					res = append(res, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						res = append(res, name.Name)
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+// newName returns the name of the exported generated name with names set by SetName, initialisms of the type,
+// prefixes and suffixes, and unexported with SetUnexported. Names taken by the source get an Enum suffix after the type name.
+func (g *Generator) newName(name string, values map[string]bool, taken map[string]bool) string {
+	if newName, ok := g.names[name]; ok {
+		return newName
+	}
+	typeName := titleCaser.String(g.Type)
+	newName := name
+	if exported := g.exportedName(g.Type); exported != typeName && !values[name] {
+		newName = strings.Replace(newName, typeName, exported, 1) // initialisms upper-cased, e.g. ParseSKUStatus
+	}
+	if name != typeName && !values[name] {
+		newName = g.namePrefix + newName + g.nameSuffix
+	}
+	if !g.unexported {
+		return newName
+	}
+	r, size := utf8.DecodeRuneInString(newName)
+	newName = string(unicode.ToLower(r)) + newName[size:]
+	if taken[newName] && strings.HasPrefix(newName, g.Type) {
+		newName = g.Type + "Enum" + newName[len(g.Type):]
+	}
+	return newName
+}
+
+// renameNames renames exported package-level names declared in the generated Go sources, test files
+// excluded, in all of them, references in comments and Go names in strings included
+func (g *Generator) renameNames(files []generatedFile, values []Value) error {
+	parsed := make([]*ast.File, len(files))
+	fsets := make([]*token.FileSet, len(files))
+	declared := g.declared
+	if g.crossPackage {
+		declared = nil // the output package is another one
+	}
+	taken := make(map[string]bool) // names declared by the source and the generated code
+	for name := range declared {
+		taken[name] = true
+	}
+	var exported []string
+	for i, f := range files {
+		fsets[i] = token.NewFileSet()
+		file, err := parser.ParseFile(fsets[i], f.name, f.src, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("failed to parse generated source: %w", err)
+		}
+		parsed[i] = file
+		if strings.HasSuffix(f.name, "_test.go") {
+			continue
+		}
+		for _, name := range declaredNames(file) {
+			if token.IsExported(name) {
+				exported = append(exported, name)
+				continue
+			}
+			taken[name] = true
+		}
+	}
+
+	var errs []error
+	for _, name := range sortedKeys(g.names) {
+		switch newName := g.names[name]; {
+		case !slices.Contains(exported, name):
+			errs = append(errs, fmt.Errorf("unknown generated name %s", name))
+		case !isValidGoIdentifier(newName) || token.IsKeyword(newName):
+			errs = append(errs, fmt.Errorf("invalid name %q for %s, expected Go identifier", newName, name))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	valueNames := make(map[string]bool, len(values))
+	for _, v := range values {
+		valueNames[v.PublicName] = true
+	}
+	newNames := make(map[string]string, len(exported))
+	for _, name := range exported {
+		newNames[name] = g.newName(name, valueNames, taken)
+		if newNames[name] != name {
+			continue
+		}
+		if declared[name] {
+			errs = append(errs, fmt.Errorf("%s is already declared in the package, set another name for it", name))
+		}
+		taken[name] = true
+	}
+	renames := make(map[string]string, len(exported))
+	for _, name := range exported {
+		newName := newNames[name]
+		if newName == name {
+			continue
+		}
+		if taken[newName] {
+			errs = append(errs, fmt.Errorf("name %s of %s is already declared in the package", newName, name))
+			continue
+		}
+		taken[newName] = true
+		renames[name] = newName
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if len(renames) == 0 {
+		return nil
+	}
+
+	typeName := titleCaser.String(g.Type)
+	words := make([]string, 0, len(renames))
+	for _, name := range sortedKeys(renames) {
+		words = append(words, regexp.QuoteMeta(name))
+	}
+	wordsRe := regexp.MustCompile(`\b(` + strings.Join(words, "|") + `)\b`)
+	for i, file := range parsed {
+		renameIdents(file, renames, wordsRe, typeName)
+		for _, cg := range file.Comments {
+			for _, c := range cg.List {
+				c.Text = wordsRe.ReplaceAllStringFunc(c.Text, func(s string) string { return renames[s] })
+			}
+		}
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fsets[i], file); err != nil {
+			return fmt.Errorf("failed to format source: %w", err)
+		}
+		files[i].src = buf.Bytes()
+	}
+	return nil
+}
+
+// renameIdents renames identifiers of the file, except for methods, struct fields and selectors. Renamed names
+// in strings, like constant names of GoString and function names in messages of generated tests, are renamed too,
+// except for the type name, which can be a value name as well, renamed only in type literals of GoString.
+func renameIdents(file *ast.File, renames map[string]string, wordsRe *regexp.Regexp, typeName string) {
+	skip := make(map[*ast.Ident]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			if n.Recv != nil {
+				skip[n.Name] = true
+			}
+			// examples are named after the identifier, e.g. ExampleParseStatus or ExampleStatus_MarshalText
+			if name, ok := strings.CutPrefix(n.Name.Name, "Example"); ok && n.Recv == nil {
+				name, method, _ := strings.Cut(name, "_")
+				if newName, ok := renames[name]; ok {
+					n.Name.Name = "Example" + newName + strings.TrimSuffix("_"+method, "_")
+				}
+			}
+		case *ast.StructType:
+			for _, field := range n.Fields.List {
+				for _, name := range field.Names {
+					skip[name] = true
+				}
+			}
+		case *ast.SelectorExpr:
+			skip[n.Sel] = true
+		case *ast.Ident:
+			if newName, ok := renames[n.Name]; ok && !skip[n] {
+				n.Name = newName
+			}
+		case *ast.BasicLit:
+			if n.Kind != token.STRING {
+				return true
+			}
+			n.Value = wordsRe.ReplaceAllStringFunc(n.Value, func(s string) string {
+				if s == typeName {
+					return s
+				}
+				return renames[s]
+			})
+			if newName, ok := renames[typeName]; ok {
+				n.Value = strings.ReplaceAll(n.Value, `"`+typeName+`{`, `"`+newName+`{`)
+			}
+		}
+		return true
+	})
+}
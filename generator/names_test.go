@@ -0,0 +1,103 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateNames(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive
+)
+
+// ParseJobStatus is hand-written and collides with the generated function
+func ParseJobStatus(s string) int { return len(s) }
+`
+	srcDir := filepath.Join(t.TempDir(), "test")
+	require.NoError(t, os.Mkdir(srcDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	newGen := func(t *testing.T) *Generator {
+		gen, err := New("jobStatus", srcDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		return gen
+	}
+
+	t.Run("collision", func(t *testing.T) {
+		gen := newGen(t)
+		assert.EqualError(t, gen.Generate(), "ParseJobStatus is already declared in the package, set another name for it")
+	})
+
+	t.Run("parse and values names", func(t *testing.T) {
+		gen := newGen(t)
+		require.NoError(t, gen.SetOption("parse-name", "DecodeJobStatus"))
+		require.NoError(t, gen.SetOption("values-name", "AllJobStatuses"))
+		require.NoError(t, gen.SetOption("name", "JobStatusIter:EachJobStatus"))
+		gen.SetGenerateTests(true)
+		gen.SetGenerateExamples(true)
+		require.NoError(t, gen.Generate())
+
+		content, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum.go"))
+		require.NoError(t, err)
+		for _, want := range []string{
+			"// DecodeJobStatus converts string to jobStatus enum value.\n",
+			"func DecodeJobStatus(v string) (JobStatus, error) {",
+			"*e, err = DecodeJobStatus(string(text))",
+			"var AllJobStatuses = []JobStatus{",
+			"func EachJobStatus() func(yield func(JobStatus) bool) {",
+			"func MustJobStatus(v string) JobStatus {",
+		} {
+			assert.Contains(t, string(content), want)
+		}
+		assert.NotContains(t, string(content), "func ParseJobStatus(")
+
+		examples, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum_example_test.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(examples), "func ExampleDecodeJobStatus() {")
+		assert.Contains(t, string(examples), "func ExampleEachJobStatus() {")
+		assert.Contains(t, string(examples), "func ExampleJobStatus_MarshalText() {")
+		tests, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum_test.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(tests), `t.Errorf("DecodeJobStatus(%q) = %v, %v", v.String(), got, err)`)
+	})
+
+	t.Run("prefix and suffix", func(t *testing.T) {
+		gen := newGen(t)
+		gen.SetNamePrefix("Enum")
+		gen.SetNameSuffix("Gen")
+		require.NoError(t, gen.Generate())
+		content, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func EnumParseJobStatusGen(v string) (JobStatus, error) {")
+		assert.Contains(t, string(content), "var EnumJobStatusValuesGen = []JobStatus{")
+		assert.Contains(t, string(content), "type JobStatus struct {", "type is not renamed")
+		assert.Contains(t, string(content), "JobStatusActive  = JobStatus{", "values are not renamed")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		gen := newGen(t)
+		gen.SetParseName("DecodeJobStatus")
+		gen.SetName("JobStatusAll", "All")
+		assert.EqualError(t, gen.Generate(), "unknown generated name JobStatusAll")
+
+		gen = newGen(t)
+		gen.SetParseName("decode-status")
+		assert.EqualError(t, gen.Generate(), `invalid name "decode-status" for ParseJobStatus, expected Go identifier`)
+
+		gen = newGen(t)
+		gen.SetParseName("MustJobStatus")
+		assert.EqualError(t, gen.Generate(), "name MustJobStatus of ParseJobStatus is already declared in the package")
+
+		assert.EqualError(t, gen.SetOption("name", "JobStatusIter"), `invalid name "JobStatusIter", expected Old:New`)
+	})
+}
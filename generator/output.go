@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// SetOutput sets the name of the generated file, used instead of "<type>_enum.go". The name is a text/template
+// with .Type, the type name as declared, and .Snake, the type name in snake case, e.g. "{{.Type}}.gen.go".
+// Test, feature and non-Go files of the type are named after it, e.g. "status.gen_test.go".
+func (g *Generator) SetOutput(pattern string) { g.fileNamePattern = pattern }
+
+// outputData is the data of the output file name template
+type outputData struct {
+	Type  string // type name as declared, e.g. "jobStatus"
+	Snake string // type name in snake case, e.g. "job_status"
+}
+
+// fileName returns the name of the generated file of the type, from the output pattern if set
+func (g *Generator) fileName() (string, error) {
+	if g.fileNamePattern == "" {
+		return g.snakeName(g.Type) + "_enum.go", nil
+	}
+	tmpl, err := template.New("output").Parse(g.fileNamePattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse output %q: %w", g.fileNamePattern, err)
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, outputData{Type: g.Type, Snake: g.snakeName(g.Type)}); err != nil {
+		return "", fmt.Errorf("failed to execute output %q: %w", g.fileNamePattern, err)
+	}
+
+	name := buf.String()
+	if err = checkFileName("output", name); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// checkFileName checks the name of a generated file is a plain non-test .go file name, kind names the
+// option it comes from in the error, e.g. "output"
+func checkFileName(kind, name string) error {
+	switch {
+	case strings.ContainsAny(name, `/\`):
+		return fmt.Errorf("%s %q is not a file name, set the directory with the output path", kind, name)
+	case !strings.HasSuffix(name, ".go") || name == ".go":
+		return fmt.Errorf("%s %q is not a .go file", kind, name)
+	case strings.HasSuffix(name, "_test.go"):
+		return fmt.Errorf("%s %q is a test file", kind, name)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateOutput(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	tmpDir := t.TempDir()
+	gen, err := New("jobStatus", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetGenerateTests(true)
+	gen.SetGenerateJSONSchema(true)
+	require.NoError(t, gen.SetOption("output", "{{.Type}}.gen.go"))
+	require.NoError(t, gen.Generate())
+
+	assert.FileExists(t, filepath.Join(tmpDir, "jobStatus.gen.go"))
+	assert.FileExists(t, filepath.Join(tmpDir, "jobStatus.gen_test.go"))
+	assert.FileExists(t, filepath.Join(tmpDir, "jobStatus.gen.schema.json"))
+	assert.NoFileExists(t, filepath.Join(tmpDir, "job_status_enum.go"))
+	paths := make([]string, 0, len(gen.Output()))
+	for _, f := range gen.Output() {
+		paths = append(paths, filepath.Base(f.Path))
+	}
+	assert.ElementsMatch(t, []string{"jobStatus.gen.go", "jobStatus.gen_test.go", "jobStatus.gen.schema.json"}, paths)
+
+	gen.SetOutput("enums_{{.Snake}}.go")
+	gen.SetGenerateTests(false)
+	gen.SetGenerateJSONSchema(false)
+	require.NoError(t, gen.Generate())
+	assert.FileExists(t, filepath.Join(tmpDir, "enums_job_status.go"))
+
+	gen.SetOutput("")
+	require.NoError(t, gen.Generate())
+	assert.FileExists(t, filepath.Join(tmpDir, "job_status_enum.go"), "default name without a pattern")
+	gen.SetOutput("{{.Snake}}.go")
+	require.NoError(t, gen.Generate())
+	assert.FileExists(t, filepath.Join(tmpDir, "job_status.go"))
+	assert.NoFileExists(t, filepath.Join(tmpDir, "job_status_enum.go"), "file with the default name is removed")
+
+	t.Run("explicit name", func(t *testing.T) {
+		outDir := t.TempDir()
+		g, err := New("jobStatus", outDir)
+		require.NoError(t, err)
+		require.NoError(t, g.Parse(srcDir))
+		g.SetOutput("states.go")
+		g.SetSplitFiles(true)
+		g.SetGenerateSQL(true)
+		require.NoError(t, g.Generate())
+		assert.FileExists(t, filepath.Join(outDir, "states.go"))
+		assert.FileExists(t, filepath.Join(outDir, "states_sql.go"))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tbl := []struct {
+			output, err string
+		}{
+			{"{{.Type", `failed to parse output "{{.Type"`},
+			{"{{.Kind}}.go", `failed to execute output "{{.Kind}}.go"`},
+			{"gen/{{.Snake}}.go", `output "gen/job_status.go" is not a file name, set the directory with the output path`},
+			{"{{.Snake}}.txt", `output "job_status.txt" is not a .go file`},
+			{"{{.Snake}}_test.go", `output "job_status_test.go" is a test file`},
+		}
+		for _, tt := range tbl {
+			gen.SetOutput(tt.output)
+			assert.ErrorContains(t, gen.Generate(), tt.err, tt.output)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// ParseFS is like Parse but reads the source files from the directory dir of fsys, e.g. an embed.FS,
+// an in-memory fstest.MapFS or an overlay of generated sources, without files on disk. The dir is
+// a slash-separated path in fsys, "." for its root. Positions in errors and diagnostics are relative
+// to fsys. Cross-package generation needs the source directory on disk and can't be used after ParseFS.
+func (g *Generator) ParseFS(fsys fs.FS, dir string) error {
+	g.srcDir = ""
+	fset := token.NewFileSet()
+	pkgs, err := parseDirFS(fset, fsys, dir, g.logSkipped(buildFilterFS(fsys, dir, g.buildTags)))
+	if err != nil {
+		return fmt.Errorf("failed to parse directory: %w", err)
+	}
+	return g.parsePackages(fset, pkgs)
+}
+
+// buildFilterFS is like buildFilter for files in the directory dir of fsys
+func buildFilterFS(fsys fs.FS, dir string, tags []string) func(fs.FileInfo) bool {
+	ctx := build.Default
+	ctx.BuildTags = append(slices.Clone(ctx.BuildTags), tags...)
+	ctx.JoinPath = path.Join
+	ctx.OpenFile = func(name string) (io.ReadCloser, error) { return fsys.Open(name) }
+	return func(fi fs.FileInfo) bool {
+		match, err := ctx.MatchFile(dir, fi.Name())
+		return match || err != nil
+	}
+}
+
+// parseDirFS is like parser.ParseDir for the directory dir of fsys, it parses Go files accepted by the filter
+func parseDirFS(fset *token.FileSet, fsys fs.FS, dir string,
+	filter func(fs.FileInfo) bool) (map[string]*ast.Package, error) { //nolint:staticcheck // same result as ParseDir
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	pkgs := make(map[string]*ast.Package) //nolint:staticcheck // same result as ParseDir
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		if !filter(info) {
+			continue
+		}
+		name := path.Join(dir, entry.Name())
+		src, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		pkg, ok := pkgs[file.Name.Name]
+		if !ok {
+			pkg = &ast.Package{Name: file.Name.Name, Files: make(map[string]*ast.File)} //nolint:staticcheck // same result as ParseDir
+			pkgs[file.Name.Name] = pkg
+		}
+		pkg.Files[name] = file
+	}
+	return pkgs, nil
+}
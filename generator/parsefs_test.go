@@ -0,0 +1,65 @@
+package generator
+
+import (
+	"bytes"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"pkg/status.go": {Data: []byte(`package test
+
+// enum: lower
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusBlocked // enum:alias=banned
+)
+`)},
+		"pkg/status_extra.go":  {Data: []byte("//go:build extra\n\npackage test\n\nconst statusExtra status = 3\n")},
+		"pkg/status_enum.go":   {Data: []byte(generatedHeader + "\npackage test\n\nconst statusStale status = 4\n")},
+		"pkg/README.md":        {Data: []byte("not go")},
+		"pkg/sub/other.go":     {Data: []byte("package sub\n\nconst statusOther = 5\n")},
+		"other/status_test.go": {Data: []byte("package test\n")},
+	}
+
+	gen, err := New("status", t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, gen.ParseFS(fsys, "pkg"))
+	assert.Equal(t, []string{"statusActive", "statusBlocked", "statusUnknown"}, sortedKeys(gen.values))
+	assert.Equal(t, "uint8", gen.underlyingType)
+	assert.True(t, gen.lowerCase, "options of the type directive are applied")
+
+	var buf bytes.Buffer
+	require.NoError(t, gen.GenerateTo(&buf))
+	assert.Contains(t, buf.String(), `"banned":  StatusBlocked,`)
+
+	t.Run("build tags", func(t *testing.T) {
+		gen, err := New("status", "")
+		require.NoError(t, err)
+		gen.SetBuildTags([]string{"extra"})
+		require.NoError(t, gen.ParseFS(fsys, "pkg"))
+		assert.Contains(t, gen.values, "statusExtra")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		gen, err := New("status", "")
+		require.NoError(t, err)
+		assert.ErrorIs(t, gen.ParseFS(fsys, "other"), ErrNoConstants)
+		assert.ErrorContains(t, gen.ParseFS(fsys, "missing"), "failed to parse directory")
+
+		broken := fstest.MapFS{"status.go": {Data: []byte("package test\n\nconst (\n")}}
+		assert.ErrorContains(t, gen.ParseFS(broken, "."), "status.go:3:9")
+
+		gen.SetCrossPackage(true)
+		gen.Path = t.TempDir()
+		require.NoError(t, gen.ParseFS(fsys, "pkg"))
+		assert.ErrorContains(t, gen.GenerateTo(&buf), "cross-package requires")
+	})
+}
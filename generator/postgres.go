@@ -0,0 +1,142 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// sqlHeader starts every generated SQL file
+const sqlHeader = "-- " + generatedComment
+
+// pgChangesMarker starts the list of ALTER TYPE statements kept between generations
+const pgChangesMarker = "-- Changes for existing databases, in order of generation"
+
+// SetGeneratePgEnum enables or disables writing a Postgres enum type of the values to <type>_enum.postgres.sql,
+// with CREATE TYPE for new databases and ALTER TYPE statements for values added since the previous generation
+func (g *Generator) SetGeneratePgEnum(v bool) { g.generatePgEnum = v }
+
+// getPgEnumFileName returns the Postgres migration file name for the generated file, e.g. "job_status_enum.postgres.sql"
+func getPgEnumFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".postgres.sql"
+}
+
+// renderPgEnum returns the Postgres enum type of the values, named as they are stored by Value. The CREATE TYPE
+// statement lists the current values, values added or removed since the previous generation of the file are
+// appended to the changes kept from it, as ALTER TYPE statements or comments for removed values which Postgres
+// can't drop. The previous file is ignored if it wasn't generated.
+func renderPgEnum(data TemplateData, previous []byte) ([]byte, error) {
+	labels := make([]string, 0, len(data.Values))
+	for _, v := range data.Values {
+		label := v.Name
+		if data.LowerCase {
+			label = strings.ToLower(label)
+		}
+		if len(label) > 63 {
+			return nil, fmt.Errorf("postgres enum label %q of %s is longer than 63 bytes", label, v.PrivateName)
+		}
+		labels = append(labels, label)
+	}
+
+	var prevLabels []string
+	var changes string
+	if isGeneratedFile(previous) {
+		var err error
+		if prevLabels, changes, err = parsePgEnum(string(previous)); err != nil {
+			return nil, fmt.Errorf("failed to parse previous postgres enum of %s: %w", data.Type, err)
+		}
+	}
+
+	var added strings.Builder
+	if prevLabels != nil {
+		for i, label := range labels {
+			if slices.Contains(prevLabels, label) {
+				continue
+			}
+			fmt.Fprintf(&added, "ALTER TYPE %s ADD VALUE IF NOT EXISTS %s", data.SQLTypeName, pgQuote(label))
+			switch {
+			case i > 0:
+				fmt.Fprintf(&added, " AFTER %s;\n", pgQuote(labels[i-1]))
+			case len(labels) > 1:
+				fmt.Fprintf(&added, " BEFORE %s;\n", pgQuote(labels[1]))
+			default:
+				added.WriteString(";\n")
+			}
+		}
+		for _, label := range prevLabels {
+			if !slices.Contains(labels, label) {
+				fmt.Fprintf(&added, "-- %s was removed from the Go enum, Postgres can't drop enum values\n", pgQuote(label))
+			}
+		}
+	}
+	changes += added.String()
+
+	quoted := make([]string, 0, len(labels))
+	for _, label := range labels {
+		quoted = append(quoted, pgQuote(label))
+	}
+	var sb strings.Builder
+	sb.WriteString(sqlHeader + "\n\n")
+	sb.WriteString("-- Postgres enum type for new databases\n")
+	fmt.Fprintf(&sb, "CREATE TYPE %s AS ENUM (%s);\n", data.SQLTypeName, strings.Join(quoted, ", "))
+	if changes != "" {
+		sb.WriteString("\n" + pgChangesMarker + "\n" + changes)
+	}
+	return []byte(sb.String()), nil
+}
+
+// parsePgEnum returns the labels of the CREATE TYPE statement and the changes section of a generated file
+func parsePgEnum(src string) (labels []string, changes string, err error) {
+	src, changes, _ = strings.Cut(src, pgChangesMarker+"\n")
+	_, create, ok := strings.Cut(src, "\nCREATE TYPE ")
+	if !ok {
+		return nil, "", errors.New("no CREATE TYPE statement")
+	}
+	_, list, ok := strings.Cut(create, "(")
+	if !ok {
+		return nil, "", errors.New("no values in CREATE TYPE statement")
+	}
+	for {
+		list = strings.TrimLeft(list, ", ")
+		if strings.HasPrefix(list, ")") {
+			return labels, changes, nil
+		}
+		if !strings.HasPrefix(list, "'") {
+			return nil, "", errors.New("invalid values in CREATE TYPE statement")
+		}
+		var label strings.Builder
+		i := 1
+		for ; i < len(list); i++ {
+			if list[i] == '\'' {
+				if i+1 < len(list) && list[i+1] == '\'' {
+					label.WriteByte('\'')
+					i++
+					continue
+				}
+				break
+			}
+			label.WriteByte(list[i])
+		}
+		if i >= len(list) {
+			return nil, "", errors.New("unterminated value in CREATE TYPE statement")
+		}
+		labels = append(labels, label.String())
+		list = list[i+1:]
+	}
+}
+
+// pgQuote returns the Postgres string literal of s
+func pgQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// readPrevious returns the content of the previously generated file in dir, nil if it doesn't exist
+func readPrevious(dir, name string) ([]byte, error) {
+	data, err := os.ReadFile(outputPath(dir, name)) //nolint:gosec // path is built from the output dir and type name
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read previous %s: %w", name, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePgEnum(t *testing.T) {
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	generate := func(t *testing.T, consts string) string {
+		t.Helper()
+		src := "package test\n\ntype jobStatus uint8\n\nconst (\n" + consts + ")\n"
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+		gen, err := New("jobStatus", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetLowerCase(true)
+		gen.SetGenerateSQL(true)
+		require.NoError(t, gen.SetOption("pg-enum", ""))
+		require.NoError(t, gen.Generate())
+		stale, err := gen.Check()
+		require.NoError(t, err)
+		assert.Empty(t, stale)
+		content, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.postgres.sql"))
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	initial := generate(t, "\tjobStatusUnknown jobStatus = iota\n\tjobStatusActive\n\tjobStatusLegacy\n")
+	assert.Equal(t, `-- Code generated by enum generator; DO NOT EDIT.
+
+-- Postgres enum type for new databases
+CREATE TYPE job_status AS ENUM ('unknown', 'active', 'legacy');
+`, initial)
+	assert.Equal(t, initial, generate(t, "\tjobStatusUnknown jobStatus = iota\n\tjobStatusActive\n\tjobStatusLegacy\n"),
+		"unchanged values")
+
+	changed := generate(t, "\tjobStatusNew jobStatus = iota\n\tjobStatusUnknown\n\tjobStatusActive\n\tjobStatusBlocked\n")
+	assert.Equal(t, `-- Code generated by enum generator; DO NOT EDIT.
+
+-- Postgres enum type for new databases
+CREATE TYPE job_status AS ENUM ('new', 'unknown', 'active', 'blocked');
+
+-- Changes for existing databases, in order of generation
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'new' BEFORE 'unknown';
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'blocked' AFTER 'active';
+-- 'legacy' was removed from the Go enum, Postgres can't drop enum values
+`, changed)
+
+	// changes are kept between generations and new ones are appended
+	changed = generate(t, "\tjobStatusNew jobStatus = iota\n\tjobStatusUnknown\n\tjobStatusActive\n\tjobStatusBlocked\n\tjobStatusOnHold\n")
+	assert.Contains(t, changed, `
+-- 'legacy' was removed from the Go enum, Postgres can't drop enum values
+ALTER TYPE job_status ADD VALUE IF NOT EXISTS 'onhold' AFTER 'blocked';
+`)
+
+	t.Run("disabled", func(t *testing.T) {
+		gen, err := New("jobStatus", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.NoError(t, gen.Generate())
+		assert.NoFileExists(t, filepath.Join(outDir, "job_status_enum.postgres.sql"), "stale file is removed")
+	})
+}
+
+func TestParsePgEnum(t *testing.T) {
+	labels, changes, err := parsePgEnum("-- header\n\nCREATE TYPE status AS ENUM ('a', 'it''s', '');\n\n" +
+		pgChangesMarker + "\nALTER TYPE status ADD VALUE IF NOT EXISTS 'it''s' AFTER 'a';\n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "it's", ""}, labels)
+	assert.Equal(t, "ALTER TYPE status ADD VALUE IF NOT EXISTS 'it''s' AFTER 'a';\n", changes)
+	assert.Equal(t, "'it''s'", pgQuote("it's"))
+
+	for _, src := range []string{"-- header\n", "-- header\nCREATE TYPE status;\n", "-- header\nCREATE TYPE status AS ENUM (a);\n",
+		"-- header\nCREATE TYPE status AS ENUM ('a"} {
+		_, _, err := parsePgEnum(src)
+		assert.Error(t, err, src)
+	}
+}
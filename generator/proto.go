@@ -0,0 +1,322 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SetProtoOut sets the directory to write the protobuf enum declaration of the type to, e.g. a directory
+// with gRPC contracts, nothing is written if empty. Relative paths are resolved against the working directory.
+func (g *Generator) SetProtoOut(dir string) {
+	g.protoOut = dir
+	if abs, err := filepath.Abs(dir); err == nil && dir != "" {
+		g.protoOut = abs
+	}
+}
+
+// getProtoFileName returns the protobuf file name for the generated file, e.g. "job_status_enum.proto"
+func getProtoFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".proto"
+}
+
+// renderProto returns a proto3 file with the enum declaration of the type. Values are named in
+// SCREAMING_SNAKE_CASE with the type prefix, e.g. STATUS_IN_PROGRESS, and keep their numeric values.
+// Proto3 requires the first value to be zero, so STATUS_UNSPECIFIED = 0 is added if there is no zero value.
+func renderProto(data TemplateData) ([]byte, error) {
+	if data.StringBacked {
+		return nil, fmt.Errorf("protobuf export is not supported for string-backed type %s", data.Type)
+	}
+
+	typeName := data.GoType
+	prefix := screamingSnake(typeName)
+	var errs []error
+	names := make(map[string]string, len(data.Values)) // proto name to private name
+	hasZero, aliased := false, false
+	seen := make(map[int]bool, len(data.Values))
+	for _, v := range data.Values {
+		if v.Index < math.MinInt32 || v.Index > math.MaxInt32 {
+			errs = append(errs, fmt.Errorf("value %d of %s doesn't fit protobuf int32", v.Index, v.PrivateName))
+		}
+		name := prefix + "_" + screamingSnake(strings.TrimPrefix(v.PublicName, typeName))
+		if other, ok := names[name]; ok {
+			errs = append(errs, fmt.Errorf("protobuf name %s of %s is the same as of %s", name, v.PrivateName, other))
+		}
+		names[name] = v.PrivateName
+		hasZero = hasZero || v.Index == 0
+		aliased = aliased || seen[v.Index]
+		seen[v.Index] = true
+	}
+	if unspecified := prefix + "_UNSPECIFIED"; !hasZero && names[unspecified] != "" {
+		errs = append(errs, fmt.Errorf("protobuf name %s of %s is reserved for the zero value", unspecified, names[unspecified]))
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(generatedHeader + "\n\n")
+	sb.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&sb, "package %s;\n\n", data.Package)
+	fmt.Fprintf(&sb, "// %s mirrors the %s.%s enum\n", typeName, data.Package, typeName)
+	fmt.Fprintf(&sb, "enum %s {\n", typeName)
+	if aliased {
+		sb.WriteString("  option allow_alias = true;\n")
+	}
+	if !hasZero {
+		fmt.Fprintf(&sb, "  %s_UNSPECIFIED = 0;\n", prefix)
+	}
+	for _, v := range data.Values {
+		if v.Description != "" {
+			fmt.Fprintf(&sb, "  // %s\n", v.Description)
+		}
+		fmt.Fprintf(&sb, "  %s_%s = %d", prefix, screamingSnake(strings.TrimPrefix(v.PublicName, typeName)), v.Index)
+		if v.Deprecated {
+			sb.WriteString(" [deprecated = true]")
+		}
+		sb.WriteString(";\n")
+	}
+	sb.WriteString("}\n")
+	return []byte(sb.String()), nil
+}
+
+// screamingSnake converts a CamelCase name to SCREAMING_SNAKE_CASE, e.g. "JobStatus" to "JOB_STATUS"
+func screamingSnake(name string) string {
+	words := splitCamelCase(name)
+	for i := range words {
+		words[i] = strings.ToUpper(strings.Trim(words[i], "_"))
+	}
+	words = strings.Fields(strings.Join(words, " ")) // drop words made of underscores only
+	return strings.Join(words, "_")
+}
+
+// protoToken is a token of a .proto file with comments attached to it
+type protoToken struct {
+	text     string
+	line     int
+	doc      []string // comment lines right before the token
+	trailing []string // comment on the same line after the token
+}
+
+// LoadProto reads enum declarations of a .proto file as a manifest, to write Go definitions of them
+// with WriteSource. Enum names become private type names, e.g. JobStatus to jobStatus, and value names
+// lose the type prefix and are converted to CamelCase, JOB_STATUS_IN_PROGRESS to jobStatusInProgress.
+// Numeric values are kept, values sharing a number with allow_alias become parsing aliases of the first one.
+func LoadProto(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // proto path is provided by the user
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proto file: %w", err)
+	}
+	tokens, err := tokenizeProto(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	m := &Manifest{header: "// Code generated by enum import-proto from " + filepath.ToSlash(path) + "; DO NOT EDIT."}
+	for i := 0; i+2 < len(tokens); i++ {
+		// enum declarations, at the top level or nested in messages; a field named "enum" is followed by "="
+		if tokens[i].text != "enum" || tokens[i+2].text != "{" || !isValidGoIdentifier(tokens[i+1].text) {
+			continue
+		}
+		tm, next, err := parseProtoEnum(tokens, i+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		if slices.ContainsFunc(m.Types, func(t TypeManifest) bool { return t.Type == tm.Type }) {
+			return nil, fmt.Errorf("failed to parse %s: duplicate enum %s", path, tokens[i+1].text)
+		}
+		m.Types = append(m.Types, tm)
+		i = next
+	}
+	if len(m.Types) == 0 {
+		return nil, fmt.Errorf("no enums found in %s", path)
+	}
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid enums in %s: %w", path, err)
+	}
+	return m, nil
+}
+
+// parseProtoEnum parses the enum declaration with the name at tokens[idx], followed by its body in braces.
+// It returns the manifest of the enum and the index of the closing brace.
+func parseProtoEnum(tokens []protoToken, idx int) (TypeManifest, int, error) {
+	enumName := tokens[idx].text
+	words := splitCamelCase(enumName)
+	words[0] = strings.ToLower(words[0])
+	tm := TypeManifest{Type: strings.Join(words, ""), Underlying: "int32"}
+	prefix := screamingSnake(enumName) + "_"
+	byNumber := make(map[int64]int) // value number to the index in tm.Values
+
+	i := idx + 1 // opening brace
+	for {
+		i++
+		if i >= len(tokens) {
+			return tm, 0, fmt.Errorf("enum %s is not closed", enumName)
+		}
+		tok := tokens[i]
+		switch tok.text {
+		case "}":
+			return tm, i, nil
+		case ";":
+			continue
+		case "option", "reserved":
+			for i < len(tokens) && tokens[i].text != ";" {
+				i++
+			}
+			continue
+		}
+
+		// value declaration: NAME = [-]NUMBER [options];
+		if i+2 >= len(tokens) || tokens[i+1].text != "=" {
+			return tm, 0, fmt.Errorf("line %d: unexpected %q in enum %s", tok.line, tok.text, enumName)
+		}
+		i += 2
+		numText := tokens[i].text
+		if numText == "-" && i+1 < len(tokens) {
+			i++
+			numText = "-" + tokens[i].text
+		}
+		num, err := strconv.ParseInt(numText, 0, 32)
+		if err != nil {
+			return tm, 0, fmt.Errorf("line %d: invalid value %s of %s", tok.line, numText, tok.text)
+		}
+		deprecated := false
+		if i+1 < len(tokens) && tokens[i+1].text == "[" {
+			for i++; i < len(tokens) && tokens[i].text != "]"; i++ {
+				if tokens[i].text == "deprecated" && i+2 < len(tokens) && tokens[i+2].text == "true" {
+					deprecated = true
+				}
+			}
+		}
+		if i+1 >= len(tokens) || tokens[i+1].text != ";" {
+			return tm, 0, fmt.Errorf("line %d: missing semicolon after %s", tok.line, tok.text)
+		}
+		i++
+
+		bare := goValueName(strings.TrimPrefix(tok.text, prefix))
+		if first, ok := byNumber[num]; ok {
+			tm.Values[first].Aliases = append(tm.Values[first].Aliases, bare)
+			continue
+		}
+		comment := tok.doc
+		if len(comment) == 0 {
+			comment = tokens[i].trailing
+		}
+		byNumber[num] = len(tm.Values)
+		tm.Values = append(tm.Values, ValueManifest{Name: tm.Type + bare, Value: json.RawMessage(strconv.FormatInt(num, 10)),
+			Comment: strings.Join(comment, " "), Deprecated: deprecated})
+	}
+}
+
+// goValueName converts a SCREAMING_SNAKE_CASE value name to CamelCase, e.g. "IN_PROGRESS" to "InProgress"
+func goValueName(name string) string {
+	var sb strings.Builder
+	for _, word := range strings.Split(name, "_") {
+		for i, r := range strings.ToLower(word) {
+			if i == 0 {
+				r = unicode.ToUpper(r)
+			}
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// tokenizeProto splits the content of a .proto file into tokens: identifiers, numbers, string literals
+// and punctuation. Comments are attached to the following token, or to the previous one on the same line.
+func tokenizeProto(src string) ([]protoToken, error) {
+	var tokens []protoToken
+	var doc []string
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			if len(doc) > 0 && i < len(src) && isBlankLine(src[i:]) {
+				doc = nil // comments separated by a blank line don't belong to the next token
+			}
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case strings.HasPrefix(src[i:], "//"), strings.HasPrefix(src[i:], "/*"):
+			var text string
+			start := line
+			if src[i+1] == '/' {
+				end := strings.IndexByte(src[i:], '\n')
+				if end < 0 {
+					end = len(src) - i
+				}
+				text = src[i+2 : i+end]
+				i += end
+			} else {
+				end := strings.Index(src[i+2:], "*/")
+				if end < 0 {
+					return nil, fmt.Errorf("line %d: unterminated comment", line)
+				}
+				text = src[i+2 : i+2+end]
+				line += strings.Count(text, "\n")
+				i += end + 4
+			}
+			var lines []string
+			for _, l := range strings.Split(text, "\n") {
+				if l = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(l), "*")); l != "" {
+					lines = append(lines, l)
+				}
+			}
+			if n := len(tokens); n > 0 && tokens[n-1].line == start && len(doc) == 0 {
+				tokens[n-1].trailing = append(tokens[n-1].trailing, lines...)
+				continue
+			}
+			doc = append(doc, lines...)
+		case c == '"' || c == '\'':
+			end := i + 1
+			for end < len(src) && src[end] != c && src[end] != '\n' {
+				if src[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(src) || src[end] != c {
+				return nil, fmt.Errorf("line %d: unterminated string", line)
+			}
+			tokens = append(tokens, protoToken{text: src[i : end+1], line: line, doc: doc})
+			doc = nil
+			i = end + 1
+		case isProtoWordChar(c):
+			end := i
+			for end < len(src) && (isProtoWordChar(src[end]) || src[end] == '.') {
+				end++
+			}
+			tokens = append(tokens, protoToken{text: src[i:end], line: line, doc: doc})
+			doc = nil
+			i = end
+		default:
+			tokens = append(tokens, protoToken{text: string(c), line: line, doc: doc})
+			doc = nil
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+// isBlankLine reports whether s starts with a line of whitespace only
+func isBlankLine(s string) bool {
+	end := strings.IndexByte(s, '\n')
+	if end < 0 {
+		return false
+	}
+	return strings.TrimSpace(s[:end]) == ""
+}
+
+// isProtoWordChar reports whether c can be a part of an identifier or a number literal
+func isProtoWordChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
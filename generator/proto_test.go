@@ -0,0 +1,279 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateProto(t *testing.T) {
+	writeSource := func(t *testing.T, src string) string {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(src), 0o600))
+		return dir
+	}
+
+	t.Run("values", func(t *testing.T) {
+		srcDir := writeSource(t, `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	// jobStatusInProgress is picked by a worker
+	jobStatusInProgress
+	jobStatusLegacy // enum:deprecated
+	jobStatus2FA
+)
+`)
+		outDir, protoDir := filepath.Join(t.TempDir(), "api"), filepath.Join(t.TempDir(), "proto")
+		gen, err := New("jobStatus", outDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.NoError(t, gen.SetOption("proto-out", protoDir))
+		require.NoError(t, gen.Generate())
+
+		proto, err := os.ReadFile(filepath.Join(protoDir, "job_status_enum.proto"))
+		require.NoError(t, err)
+		assert.Equal(t, `// Code generated by enum generator; DO NOT EDIT.
+
+syntax = "proto3";
+
+package api;
+
+// JobStatus mirrors the api.JobStatus enum
+enum JobStatus {
+  JOB_STATUS_UNKNOWN = 0;
+  // jobStatusInProgress is picked by a worker
+  JOB_STATUS_IN_PROGRESS = 1;
+  JOB_STATUS_LEGACY = 2 [deprecated = true];
+  JOB_STATUS_2FA = 3;
+}
+`, string(proto))
+		assert.FileExists(t, filepath.Join(outDir, "job_status_enum.go"))
+		require.Len(t, gen.Output(), 2)
+		assert.Equal(t, filepath.Join(protoDir, "job_status_enum.proto"), gen.Output()[1].Path)
+	})
+
+	t.Run("no zero value and aliases", func(t *testing.T) {
+		srcDir := writeSource(t, `package test
+
+type status int
+
+const (
+	statusActive status = iota + 1
+	statusBlocked
+	statusEnabled = statusActive // enum:alias
+)
+`)
+		protoDir := t.TempDir()
+		gen, err := New("status", filepath.Join(t.TempDir(), "api"))
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		gen.SetProtoOut(protoDir)
+		require.NoError(t, gen.Generate())
+
+		proto, err := os.ReadFile(filepath.Join(protoDir, "status_enum.proto"))
+		require.NoError(t, err)
+		assert.Contains(t, string(proto), `enum Status {
+  option allow_alias = true;
+  STATUS_UNSPECIFIED = 0;
+  STATUS_ACTIVE = 1;
+  STATUS_BLOCKED = 2;
+  STATUS_ENABLED = 1;
+}
+`)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tbl := []struct {
+			name, src, err string
+		}{
+			{name: "string backed", src: `package test
+type status string
+const (
+	statusActive status = "active"
+)
+`, err: "protobuf export is not supported for string-backed type status"},
+			{name: "reserved unspecified", src: `package test
+type status int
+const (
+	statusUnspecified status = iota + 1
+	statusActive
+)
+`, err: "protobuf name STATUS_UNSPECIFIED of statusUnspecified is reserved for the zero value"},
+			{name: "same names", src: `package test
+type status int
+const (
+	statusInProgress status = iota
+	statusIn_Progress
+)
+`, err: "protobuf name STATUS_IN_PROGRESS of statusIn_Progress is the same as of statusInProgress"},
+			{name: "out of int32", src: `package test
+type status int64
+const (
+	statusNone status = 0
+	statusHuge status = 1 << 40
+)
+`, err: "value 1099511627776 of statusHuge doesn't fit protobuf int32"},
+		}
+		for _, tt := range tbl {
+			t.Run(tt.name, func(t *testing.T) {
+				gen, err := New("status", t.TempDir())
+				require.NoError(t, err)
+				require.NoError(t, gen.Parse(writeSource(t, tt.src)))
+				gen.SetProtoOut(t.TempDir())
+				err = gen.Generate()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+			})
+		}
+	})
+}
+
+func TestScreamingSnake(t *testing.T) {
+	tbl := []struct{ in, out string }{
+		{"JobStatus", "JOB_STATUS"},
+		{"InProgress", "IN_PROGRESS"},
+		{"HTTPCode", "HTTP_CODE"},
+		{"2FA", "2FA"},
+		{"In_Progress", "IN_PROGRESS"},
+		{"Active", "ACTIVE"},
+	}
+	for _, tt := range tbl {
+		assert.Equal(t, tt.out, screamingSnake(tt.in), tt.in)
+	}
+}
+
+func TestLoadProto(t *testing.T) {
+	src := `syntax = "proto3";
+
+package jobs.v1;
+
+option go_package = "example.com/jobs/v1;jobsv1";
+
+// JobStatus is the state of a job
+enum JobStatus {
+  option allow_alias = true;
+  reserved 5, 6 to 8;
+  reserved "JOB_STATUS_OLD";
+
+  JOB_STATUS_UNSPECIFIED = 0;
+  // picked by a worker
+  // and running
+  JOB_STATUS_IN_PROGRESS = 1;
+  JOB_STATUS_RUNNING = 1;
+  JOB_STATUS_DONE = 2; // finished successfully
+
+  /* replaced by DONE */
+  JOB_STATUS_LEGACY = 3 [deprecated = true];
+  JOB_STATUS_2FA = 0x10;
+}
+
+message Job {
+  enum Priority {
+    PRIORITY_LOW = 0;
+    PRIORITY_HIGH = -1;
+  }
+  JobStatus status = 1;
+  string enum = 2;
+}
+`
+	protoDir := t.TempDir()
+	path := filepath.Join(protoDir, "jobs.proto")
+	require.NoError(t, os.WriteFile(path, []byte(src), 0o600))
+
+	m, err := LoadProto(path)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, m.WriteSource(&buf, "jobs", nil))
+	assert.Equal(t, "// Code generated by enum import-proto from "+filepath.ToSlash(path)+`; DO NOT EDIT.
+
+package jobs
+
+type jobStatus int32
+
+const (
+	jobStatusUnspecified jobStatus = 0
+	// picked by a worker and running
+	jobStatusInProgress jobStatus = 1 // enum:alias=Running
+	// finished successfully
+	jobStatusDone jobStatus = 2
+	// replaced by DONE
+	jobStatusLegacy jobStatus = 3 // enum:deprecated
+	jobStatus2fa    jobStatus = 16
+)
+
+type priority int32
+
+const (
+	priorityLow  priority = 0
+	priorityHigh priority = -1
+)
+`, buf.String())
+
+	// generated enum exports back to the same proto values
+	outDir := filepath.Join(t.TempDir(), "jobs")
+	require.NoError(t, os.MkdirAll(outDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, "enums_proto.go"), buf.Bytes(), 0o600))
+	gen, err := New("jobStatus", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(outDir))
+	gen.SetProtoOut(protoDir)
+	require.NoError(t, gen.Generate())
+	exported, err := os.ReadFile(filepath.Join(protoDir, "job_status_enum.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(exported), `
+  JOB_STATUS_UNSPECIFIED = 0;
+  // picked by a worker and running
+  JOB_STATUS_IN_PROGRESS = 1;
+  // finished successfully
+  JOB_STATUS_DONE = 2;
+  // replaced by DONE
+  JOB_STATUS_LEGACY = 3 [deprecated = true];
+  JOB_STATUS_2FA = 16;
+}`)
+
+	t.Run("errors", func(t *testing.T) {
+		tbl := []struct {
+			name, src, err string
+		}{
+			{name: "no enums", src: "syntax = \"proto3\";\nmessage Job {}\n", err: "no enums found in"},
+			{name: "not closed", src: "enum Status {\n  STATUS_A = 0;\n", err: "enum Status is not closed"},
+			{name: "invalid value", src: "enum Status {\n  STATUS_A = A;\n}\n", err: "line 2: invalid value A of STATUS_A"},
+			{name: "out of int32", src: "enum Status {\n  STATUS_A = 4294967296;\n}\n", err: "line 2: invalid value 4294967296 of STATUS_A"},
+			{name: "no semicolon", src: "enum Status {\n  STATUS_A = 0\n}\n", err: "line 2: missing semicolon after STATUS_A"},
+			{name: "unexpected", src: "enum Status {\n  STATUS_A;\n}\n", err: `line 2: unexpected "STATUS_A" in enum Status`},
+			{name: "unterminated comment", src: "/* enum Status {}", err: "line 1: unterminated comment"},
+			{name: "duplicate", src: "enum Status {\n  STATUS_A = 0;\n}\nmessage M { enum Status { STATUS_B = 0; } }\n",
+				err: "duplicate enum Status"},
+		}
+		for _, tt := range tbl {
+			t.Run(tt.name, func(t *testing.T) {
+				path := filepath.Join(t.TempDir(), "bad.proto")
+				require.NoError(t, os.WriteFile(path, []byte(tt.src), 0o600))
+				_, err := LoadProto(path)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+			})
+		}
+		_, err := LoadProto(filepath.Join(t.TempDir(), "missing.proto"))
+		require.Error(t, err)
+	})
+}
+
+func TestGoValueName(t *testing.T) {
+	tbl := []struct{ in, out string }{
+		{"IN_PROGRESS", "InProgress"},
+		{"ACTIVE", "Active"},
+		{"2FA", "2fa"},
+		{"HTTP__CODE", "HttpCode"},
+	}
+	for _, tt := range tbl {
+		assert.Equal(t, tt.out, goValueName(tt.in), tt.in)
+	}
+}
@@ -0,0 +1,259 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// RenameValue renames the enum value from one name to another, e.g. RenameValue("Blocked", "Suspended", "")
+// renames statusBlocked to statusSuspended in all non-generated files of the enum package. Names can be given
+// as bare (Blocked), private (statusBlocked) or public (StatusBlocked) names.
+// If root is not empty, references to the public constant (StatusBlocked) are rewritten in all packages
+// under root as well, qualified by a name the file imports the enum package with, e.g. status.StatusBlocked.
+// Identifiers are located with go/ast and replaced in place, so formatting and comments are preserved, and
+// files are written only after all of them are rewritten. The parsed values are updated, so Generate can be
+// called right after to regenerate the code.
+// Returns the list of modified files. Parse must be called first.
+func (g *Generator) RenameValue(from, to, root string) ([]string, error) {
+	privateFrom := g.privateValueName(from)
+	cv, ok := g.values[privateFrom]
+	if !ok {
+		return nil, fmt.Errorf("value %s not found for type %s", from, g.Type)
+	}
+	privateTo := g.privateValueName(to)
+	if privateTo == g.Type || !isValidGoIdentifier(privateTo) {
+		return nil, fmt.Errorf("invalid new name %q", to)
+	}
+	if _, exists := g.values[privateTo]; exists {
+		return nil, fmt.Errorf("value %s already exists for type %s", privateTo, g.Type)
+	}
+
+	pkgDir, err := filepath.Abs(g.srcDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve source directory: %w", err)
+	}
+
+	r := &renamer{
+		privateFrom: privateFrom,
+		privateTo:   privateTo,
+		publicFrom:  g.publicValueName(privateFrom, cv),
+		publicTo:    g.publicValueName(privateTo, cv),
+		pkgName:     g.pkgName,
+		refs:        root != "" && cv.public == "", // a name pinned with enum:public= doesn't change
+	}
+	if r.refs {
+		if r.pkgPath, err = g.importPath(); err != nil {
+			return nil, err
+		}
+	}
+
+	scanRoot := pkgDir
+	if root != "" {
+		scanRoot = root
+	}
+
+	// all files are rewritten in memory first, so a file failing to parse leaves the module untouched
+	var edited []renamedFile
+	walkErr := filepath.WalkDir(scanRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != scanRoot && (root == "" || name == "vendor" || name == "testdata" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		absDir, err := filepath.Abs(filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		f, err := r.renameInFile(path, absDir == pkgDir)
+		if err != nil {
+			return err
+		}
+		if f != nil {
+			edited = append(edited, *f)
+		}
+		return nil
+	})
+	if walkErr != nil {
+		return nil, fmt.Errorf("failed to rename %s: %w", privateFrom, walkErr)
+	}
+
+	changed := make([]string, 0, len(edited))
+	for _, f := range edited {
+		if err := os.WriteFile(f.path, f.content, f.perm); err != nil {
+			return nil, fmt.Errorf("failed to rename %s: %w", privateFrom, err)
+		}
+		changed = append(changed, f.path)
+	}
+
+	// keep parsed values in sync with the renamed source
+	delete(g.values, privateFrom)
+	g.values[privateTo] = cv
+
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// privateValueName converts bare, private or public value name to the private constant name
+func (g *Generator) privateValueName(name string) string {
+	if strings.HasPrefix(name, g.Type) {
+		return name
+	}
+	for privateName, cv := range g.values {
+		if cv.public != "" && cv.public == name {
+			return privateName
+		}
+	}
+	if title := titleCaser.String(g.Type); strings.HasPrefix(name, title) {
+		return g.Type + strings.TrimPrefix(name, title)
+	}
+	return g.Type + name
+}
+
+// importPath returns the import path of the enum package, to match qualified references in other packages
+func (g *Generator) importPath() (string, error) {
+	cfg := &packages.Config{Mode: packages.NeedName, Dir: g.srcDir}
+	if len(g.buildTags) > 0 {
+		cfg.BuildFlags = []string{"-tags=" + strings.Join(g.buildTags, ",")}
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return "", fmt.Errorf("failed to load package of %s: %w", g.Type, err)
+	}
+	if len(pkgs) != 1 || pkgs[0].PkgPath == "" {
+		return "", fmt.Errorf("failed to resolve import path of %s", g.srcDir)
+	}
+	return pkgs[0].PkgPath, nil
+}
+
+// renamer rewrites identifiers of a single enum value
+type renamer struct {
+	privateFrom, privateTo string
+	publicFrom, publicTo   string
+	pkgName                string // name of the enum package
+	pkgPath                string // import path of the enum package, set with refs
+	refs                   bool   // rewrite references to the public constant
+}
+
+// renamedFile is the rewritten content of a file, written once all files are renamed
+type renamedFile struct {
+	path    string
+	content []byte
+	perm    fs.FileMode
+}
+
+// renameInFile rewrites identifiers in the file, returns nil if nothing was renamed.
+// Generated files are skipped as they are recreated by the generator.
+func (r *renamer) renameInFile(path string, samePackage bool) (*renamedFile, error) {
+	src, err := os.ReadFile(path) //nolint:gosec // path comes from walking the module
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+	// external tests in the directory of the package, package status_test, reference it by import
+	samePackage = samePackage && file.Name.Name == r.pkgName
+	qualifiers, dotImport := r.importNames(file)
+
+	type edit struct {
+		offset int
+		from   string
+		to     string
+	}
+	var edits []edit
+	add := func(ident *ast.Ident, to string) {
+		edits = append(edits, edit{offset: fset.Position(ident.Pos()).Offset, from: ident.Name, to: to})
+	}
+	inspectIdent := func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		switch {
+		case samePackage && ident.Name == r.privateFrom:
+			add(ident, r.privateTo)
+		case (samePackage || dotImport) && r.refs && ident.Name == r.publicFrom:
+			add(ident, r.publicTo)
+		}
+		return true
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.ImportSpec:
+			return false
+		case *ast.SelectorExpr:
+			// qualified reference from another package, e.g. status.StatusBlocked, with status imported
+			// from the enum package
+			if x, ok := node.X.(*ast.Ident); ok && r.refs && node.Sel.Name == r.publicFrom && qualifiers[x.Name] {
+				add(node.Sel, r.publicTo)
+			}
+			ast.Inspect(node.X, inspectIdent)
+			return false
+		}
+		return inspectIdent(n)
+	})
+	if len(edits) == 0 {
+		return nil, nil
+	}
+
+	// apply edits from the end to keep offsets valid
+	sort.Slice(edits, func(i, j int) bool { return edits[i].offset > edits[j].offset })
+	out := src
+	for _, e := range edits {
+		out = append(out[:e.offset:e.offset], append([]byte(e.to), out[e.offset+len(e.from):]...)...)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &renamedFile{path: path, content: out, perm: info.Mode().Perm()}, nil
+}
+
+// importNames returns the names the file refers to the enum package by, e.g. status or an alias of
+// the import, and whether the package is dot-imported
+func (r *renamer) importNames(file *ast.File) (names map[string]bool, dot bool) {
+	names = make(map[string]bool)
+	if !r.refs {
+		return names, false
+	}
+	for _, spec := range file.Imports {
+		if path, err := strconv.Unquote(spec.Path.Value); err != nil || path != r.pkgPath {
+			continue
+		}
+		switch {
+		case spec.Name == nil:
+			names[r.pkgName] = true
+		case spec.Name.Name == ".":
+			dot = true
+		case spec.Name.Name != "_":
+			names[spec.Name.Name] = true
+		}
+	}
+	return names, dot
+}
@@ -0,0 +1,195 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRenameModule creates a module with the status enum package and a package referencing it
+func setupRenameModule(t *testing.T) (root, pkgDir string) {
+	t.Helper()
+	root = t.TempDir()
+	pkgDir = filepath.Join(root, "status")
+	otherDir := filepath.Join(root, "other")
+	for _, dir := range []string{pkgDir, otherDir, filepath.Join(root, "legacy"), filepath.Join(root, "dot")} {
+		require.NoError(t, os.MkdirAll(dir, 0o750))
+	}
+
+	files := map[string]string{
+		filepath.Join(root, "go.mod"): "module example.com/app\n\ngo 1.24\n",
+		filepath.Join(pkgDir, "status.go"): `package status
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusBlocked // blocked by admin
+)
+`,
+		filepath.Join(pkgDir, "logic.go"): `package status
+
+func isBlocked(s status) bool { return s == statusBlocked }
+
+var defaultBlocked = StatusBlocked
+`,
+		filepath.Join(otherDir, "other.go"): `package other
+
+import "example.com/app/status"
+
+const statusBlocked = 2 // unrelated
+
+var x = status.StatusBlocked
+`,
+		filepath.Join(otherDir, "alias.go"): `package other
+
+import st "example.com/app/status"
+
+var y = st.StatusBlocked
+`,
+		filepath.Join(otherDir, "legacy.go"): `package other
+
+import status "example.com/app/legacy"
+
+var z = status.StatusBlocked
+`,
+		filepath.Join(root, "legacy", "legacy.go"): `package legacy
+
+const StatusBlocked = 3
+`,
+		filepath.Join(root, "dot", "dot.go"): `package dot
+
+import . "example.com/app/status"
+
+var d = StatusBlocked
+`,
+		filepath.Join(pkgDir, "status_ext_test.go"): `package status_test
+
+import "example.com/app/status"
+
+var statusBlocked = status.StatusBlocked
+`,
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+	return root, pkgDir
+}
+
+func TestRenameValue(t *testing.T) {
+	t.Run("package only", func(t *testing.T) {
+		_, pkgDir := setupRenameModule(t)
+
+		gen, err := New("status", pkgDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(pkgDir))
+
+		changed, err := gen.RenameValue("Blocked", "Suspended", "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(pkgDir, "logic.go"), filepath.Join(pkgDir, "status.go")}, changed)
+
+		content, err := os.ReadFile(filepath.Join(pkgDir, "status.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\tstatusSuspended // blocked by admin\n")
+
+		content, err = os.ReadFile(filepath.Join(pkgDir, "logic.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "return s == statusSuspended }")
+		assert.Contains(t, string(content), "var defaultBlocked = StatusBlocked", "public refs untouched without root")
+
+		require.NoError(t, gen.Generate())
+		content, err = os.ReadFile(filepath.Join(pkgDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "\npackage status\n", "not the package of external tests")
+		assert.Contains(t, string(content), "StatusSuspended")
+		assert.NotContains(t, string(content), "StatusBlocked")
+	})
+
+	t.Run("with module references", func(t *testing.T) {
+		root, pkgDir := setupRenameModule(t)
+
+		gen, err := New("status", pkgDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(pkgDir))
+
+		changed, err := gen.RenameValue("StatusBlocked", "statusSuspended", root)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(root, "dot", "dot.go"),
+			filepath.Join(root, "other", "alias.go"),
+			filepath.Join(root, "other", "other.go"),
+			filepath.Join(pkgDir, "logic.go"),
+			filepath.Join(pkgDir, "status.go"),
+			filepath.Join(pkgDir, "status_ext_test.go"),
+		}, changed)
+
+		content, err := os.ReadFile(filepath.Join(pkgDir, "logic.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "var defaultBlocked = StatusSuspended")
+
+		content, err = os.ReadFile(filepath.Join(root, "other", "other.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "var x = status.StatusSuspended")
+		assert.Contains(t, string(content), "const statusBlocked = 2", "private names in other packages untouched")
+
+		content, err = os.ReadFile(filepath.Join(root, "other", "alias.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "var y = st.StatusSuspended", "renamed import")
+
+		content, err = os.ReadFile(filepath.Join(root, "other", "legacy.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "var z = status.StatusBlocked", "another package imported as status")
+
+		content, err = os.ReadFile(filepath.Join(root, "dot", "dot.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "var d = StatusSuspended", "dot import")
+
+		content, err = os.ReadFile(filepath.Join(pkgDir, "status_ext_test.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "var statusBlocked = status.StatusSuspended", "external test package")
+	})
+
+	t.Run("parse error leaves files untouched", func(t *testing.T) {
+		root, pkgDir := setupRenameModule(t)
+		require.NoError(t, os.WriteFile(filepath.Join(root, "other", "broken.go"), []byte("package other\n\nfunc {"), 0o600))
+
+		gen, err := New("status", pkgDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(pkgDir))
+
+		_, err = gen.RenameValue("Blocked", "Suspended", root)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to rename statusBlocked")
+
+		for _, name := range []string{filepath.Join(pkgDir, "status.go"), filepath.Join(pkgDir, "logic.go"), filepath.Join(root, "dot", "dot.go")} {
+			content, err := os.ReadFile(name)
+			require.NoError(t, err)
+			assert.Contains(t, string(content), "Blocked", name)
+			assert.NotContains(t, string(content), "Suspended", name)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, pkgDir := setupRenameModule(t)
+
+		gen, err := New("status", pkgDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(pkgDir))
+
+		_, err = gen.RenameValue("Missing", "Other", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "value Missing not found")
+
+		_, err = gen.RenameValue("Blocked", "Active", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "value statusActive already exists")
+
+		_, err = gen.RenameValue("Blocked", "Bad-Name", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid new name "Bad-Name"`)
+	})
+}
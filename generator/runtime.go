@@ -0,0 +1,38 @@
+package generator
+
+import "fmt"
+
+// SetRuntime enables or disables the shared runtime mode. The generated code keeps the type, the constants
+// and thin methods and functions delegating parsing, marshaling and iteration to an Enum of the
+// github.com/go-pkgz/enum/enumrt package, instead of repeating the logic in every generated file.
+func (g *Generator) SetRuntime(v bool) { g.runtime = v }
+
+// checkRuntime reports options the thin code of the runtime mode doesn't support
+func (g *Generator) checkRuntime() error {
+	if !g.runtime {
+		return nil
+	}
+	for _, opt := range []struct {
+		name    string
+		enabled bool
+	}{
+		{"getter", g.generateGetter}, {"bson", g.generateBSON}, {"yaml", g.generateYAML}, {"toml", g.generateTOML},
+		{"pgx", g.generatePgx}, {"split", g.splitFiles}, {"flags", g.generateFlags}, {"set", g.generateSet},
+		{"jsonmap", g.generateJSONMap}, {"map", g.generateMap}, {"checked", g.generateChecked},
+		{"completion", g.generateCompletion}, {"env", g.generateEnv}, {"swag", g.generateSwag},
+		{"formatter", g.generateFormatter}, {"packed", g.packed}, {"error-values", g.errorValues},
+		{"fallback", g.fallback != ""}, {"null-value", g.nullValue != ""}, {"null-strict", g.nullStrict},
+		{"null", g.generateNull}, {"graphql", g.generateGraphQL}, {"redis", g.generateRedis}, {"ent", g.generateEnt},
+		{"jsonexample", g.generateJSONExample}, {"info", g.generateInfo}, {"descriptor", g.generateDescriptor},
+		{"registry", g.generateRegistry}, {"immutable", g.immutableValues}, {"seq", g.generateSeq},
+		{"sorted", g.generateSorted}, {"jsonschema", g.generateJSONSchema}, {"marshal-number", g.marshalNumber},
+		{"tolerant", g.tolerant}, {"translations", g.translations != ""}, {"method", len(g.methods) > 0},
+		{"unexported", g.unexported}, {"parse", g.parseMode != "" && g.parseMode != "map"}, {"generic", g.generic},
+		{"template", g.templatePath != "" || len(g.templateExtras) > 0},
+	} {
+		if opt.enabled {
+			return fmt.Errorf("runtime can't be used with %s, the shared runtime doesn't support it", opt.name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRuntime(t *testing.T) {
+	tmpDir := t.TempDir()
+	gen, err := New("status", tmpDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse("testdata"))
+	require.NoError(t, gen.SetOption("runtime", ""))
+	gen.SetGenerateSQL(true)
+	gen.SetLowerCase(true)
+	gen.SetGenerateTests(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"\t\"github.com/go-pkgz/enum/enumrt\"\n",
+		"func ParseStatus(v string) (Status, error) { return _statusEnum.Parse(v) }",
+		"func (e Status) MarshalText() ([]byte, error) { return _statusEnum.MarshalText(e) }",
+		"*e, err = _statusEnum.Scan(value)",
+		`StatusActive   = Status{name: "active", value: 1}`,
+		"func StatusIter() func(yield func(Status) bool) { return _statusEnum.All() }",
+		"var _statusEnum = enumrt.New(enumrt.Config[Status, uint8]{",
+		`ErrorName: "status",`,
+		"var _ status = statusActive",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+	assert.NotContains(t, string(content), "_statusParseMap", "parsing is delegated")
+	assert.FileExists(t, filepath.Join(tmpDir, "status_enum_test.go"))
+
+	t.Run("unsupported", func(t *testing.T) {
+		gen, err := New("status", t.TempDir())
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse("testdata"))
+		gen.SetRuntime(true)
+		gen.SetGenerateBSON(true)
+		assert.EqualError(t, gen.Generate(), "runtime can't be used with bson, the shared runtime doesn't support it")
+	})
+}
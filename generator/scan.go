@@ -0,0 +1,168 @@
+package generator
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// GenerateDirective is a //go:generate line running the enum generator
+type GenerateDirective struct {
+	Pos  token.Position // file and line of the directive
+	Line string         // the directive as written, without the //go:generate prefix
+	Args []string       // arguments of the generator, e.g. ["-type", "status", "-lower"]
+	Err  error          // error of splitting the line into words, e.g. an unterminated quoted string
+}
+
+// enumModule is the import path of the generator, as run by "go run" and "go tool" directives
+const enumModule = "github.com/go-pkgz/enum"
+
+// FindGenerateDirectives returns //go:generate directives running the enum generator in Go files of the
+// directory, and of all directories below it, like the ./... pattern does, if recursive. Vendor, testdata
+// and hidden directories are skipped. Directives are recognized in the forms "go run github.com/go-pkgz/enum",
+// with an optional version, "go tool enum" and "enum", other directives are ignored. Words of the line are
+// split and expanded like go generate does, with $GOFILE, $GOLINE, $GOPACKAGE, $DOLLAR and environment
+// variables, and double-quoted strings as single words.
+func FindGenerateDirectives(dir string, recursive bool) ([]GenerateDirective, error) {
+	var res []GenerateDirective
+	walkErr := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != dir && (!recursive || name == "vendor" || name == "testdata" ||
+				strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		directives, err := fileGenerateDirectives(path)
+		if err != nil {
+			return err
+		}
+		res = append(res, directives...)
+		return nil
+	})
+	if walkErr != nil {
+		return nil, fmt.Errorf("failed to scan %s: %w", dir, walkErr)
+	}
+	return res, nil
+}
+
+// fileGenerateDirectives returns the enum generator directives of the Go file
+func fileGenerateDirectives(path string) ([]GenerateDirective, error) {
+	src, err := os.ReadFile(path) //nolint:gosec // files of the scanned module
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Contains(src, []byte("//go:generate")) {
+		return nil, nil
+	}
+	var pkgName string
+	if f, err := parser.ParseFile(token.NewFileSet(), path, src, parser.PackageClauseOnly); err == nil {
+		pkgName = f.Name.Name
+	}
+
+	var res []GenerateDirective
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	scanner.Buffer(nil, len(src)+1)
+	for n := 1; scanner.Scan(); n++ {
+		line, ok := strings.CutPrefix(scanner.Text(), "//go:generate")
+		if !ok || line == "" || (line[0] != ' ' && line[0] != '\t') {
+			continue
+		}
+		line = strings.TrimSpace(line)
+		expand := func(name string) string {
+			switch name {
+			case "GOFILE":
+				return filepath.Base(path)
+			case "GOLINE":
+				return strconv.Itoa(n)
+			case "GOPACKAGE":
+				return pkgName
+			case "DOLLAR":
+				return "$"
+			}
+			return os.Getenv(name)
+		}
+		words, err := splitGenerateLine(line, expand)
+		args, isEnum := enumArgs(words)
+		if err == nil && !isEnum {
+			continue
+		}
+		if err != nil && !strings.Contains(line, "enum") {
+			continue // a broken directive of another tool
+		}
+		res = append(res, GenerateDirective{Pos: token.Position{Filename: path, Line: n}, Line: line, Args: args, Err: err})
+	}
+	return res, nil
+}
+
+// splitGenerateLine splits the line of a //go:generate directive into words like go generate does:
+// double-quoted strings are unquoted as single words, and all words are expanded with the function
+func splitGenerateLine(line string, expand func(string) string) ([]string, error) {
+	var words []string
+	for line = strings.TrimLeft(line, " \t"); line != ""; line = strings.TrimLeft(line, " \t") {
+		if line[0] == '"' {
+			end := 1
+			for ; end < len(line) && line[end] != '"'; end++ {
+				if line[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(line) {
+				return nil, fmt.Errorf("unterminated quoted string in %q", line)
+			}
+			word, err := strconv.Unquote(line[:end+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted string %s", line[:end+1])
+			}
+			words = append(words, os.Expand(word, expand))
+			line = line[end+1:]
+			continue
+		}
+		end := strings.IndexAny(line, " \t")
+		if end < 0 {
+			end = len(line)
+		}
+		words = append(words, os.Expand(line[:end], expand))
+		line = line[end:]
+	}
+	return words, nil
+}
+
+// enumArgs returns the arguments of the generator if the words of a directive run it
+func enumArgs(words []string) ([]string, bool) {
+	isEnum := func(pkg string) bool {
+		path, _, _ := strings.Cut(pkg, "@")
+		return path == enumModule
+	}
+	switch {
+	case len(words) == 0:
+		return nil, false
+	case words[0] == "enum":
+		return words[1:], true
+	case len(words) > 2 && words[0] == "go" && words[1] == "tool":
+		return words[3:], words[2] == "enum" || isEnum(words[2])
+	case len(words) > 2 && words[0] == "go" && words[1] == "run":
+		i := 2
+		for i < len(words) && strings.HasPrefix(words[i], "-") {
+			i++ // flags of go run, e.g. -mod=mod
+		}
+		if i < len(words) && isEnum(words[i]) {
+			return words[i+1:], true
+		}
+	}
+	return nil, false
+}
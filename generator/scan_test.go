@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindGenerateDirectives(t *testing.T) {
+	root := t.TempDir()
+	write := func(path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(root, path)), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(root, path), []byte(content), 0o600))
+	}
+	write("a/status.go", `package a
+
+//go:generate go run github.com/go-pkgz/enum@v1.3.0 -type status -lower
+//go:generate enum -type "role" -path "$GOPACKAGE/gen"
+//go:generate stringer -type=kind
+//go:generate go tool enum -type kind
+//go:generatex enum -type other
+type status int
+`)
+	write("a/b/b.go", "package b\n\n//go:generate go run -mod=mod github.com/go-pkgz/enum -type b_$GOLINE\n")
+	write("a/testdata/skip.go", "package skip\n\n//go:generate enum -type skipped\n")
+	write("c/c.go", "package c\n\n//go:generate enum -type \"broken\n")
+
+	directives, err := FindGenerateDirectives(filepath.Join(root, "a"), true)
+	require.NoError(t, err)
+	require.Len(t, directives, 4)
+	assert.Equal(t, filepath.Join(root, "a", "b", "b.go"), directives[0].Pos.Filename)
+	assert.Equal(t, []string{"-type", "b_3"}, directives[0].Args)
+	assert.Equal(t, 3, directives[1].Pos.Line)
+	assert.Equal(t, "go run github.com/go-pkgz/enum@v1.3.0 -type status -lower", directives[1].Line)
+	assert.Equal(t, []string{"-type", "status", "-lower"}, directives[1].Args)
+	assert.Equal(t, []string{"-type", "role", "-path", "a/gen"}, directives[2].Args)
+	assert.Equal(t, []string{"-type", "kind"}, directives[3].Args)
+
+	directives, err = FindGenerateDirectives(filepath.Join(root, "a"), false)
+	require.NoError(t, err)
+	assert.Len(t, directives, 3, "subdirectories are skipped")
+
+	directives, err = FindGenerateDirectives(filepath.Join(root, "c"), false)
+	require.NoError(t, err)
+	require.Len(t, directives, 1)
+	assert.EqualError(t, directives[0].Err, `unterminated quoted string in "\"broken"`)
+
+	_, err = FindGenerateDirectives(filepath.Join(root, "missing"), true)
+	assert.Error(t, err)
+}
+
+func TestEnumArgs(t *testing.T) {
+	tests := []struct {
+		words []string
+		args  []string
+		ok    bool
+	}{
+		{[]string{"enum", "-type", "status"}, []string{"-type", "status"}, true},
+		{[]string{"go", "run", "github.com/go-pkgz/enum@latest", "-all"}, []string{"-all"}, true},
+		{[]string{"go", "run", "-mod=mod", "github.com/go-pkgz/enum"}, []string{}, true},
+		{[]string{"go", "tool", "github.com/go-pkgz/enum", "-all"}, []string{"-all"}, true},
+		{[]string{"go", "run", "github.com/go-pkgz/enumx", "-all"}, nil, false},
+		{[]string{"go", "run", "./cmd/enum"}, nil, false},
+		{[]string{"stringer", "-type", "status"}, nil, false},
+		{nil, nil, false},
+	}
+	for _, tt := range tests {
+		args, ok := enumArgs(tt.words)
+		assert.Equal(t, tt.ok, ok, tt.words)
+		assert.Equal(t, tt.args, args, tt.words)
+	}
+}
@@ -0,0 +1,155 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// schemaHeader starts every generated JSON schema, the "$comment" keyword marks it as generated
+// the same way as the header of generated Go files
+const schemaHeader = "{\n  \"$comment\": \"" + generatedComment + "\""
+
+// openAPIHeader starts every generated OpenAPI schema
+const openAPIHeader = "# " + generatedComment
+
+// jsonSchema is a JSON Schema (draft 2020-12) of an enum type, fields are in the order of the output
+type jsonSchema struct {
+	Comment      string            `json:"$comment"`
+	Schema       string            `json:"$schema"`
+	Title        string            `json:"title"`
+	Type         string            `json:"type"`
+	Enum         []any             `json:"enum"`
+	OneOf        []jsonSchemaValue `json:"oneOf,omitempty"`
+	GoType       string            `json:"x-go-type"`
+	GoUnderlying string            `json:"x-go-underlying,omitempty"`
+}
+
+// openAPISchema is an OpenAPI 3 schema of an enum type, fields are in the order of the output
+type openAPISchema struct {
+	Type         string   `yaml:"type"`
+	Format       string   `yaml:"format,omitempty"`
+	Enum         []any    `yaml:"enum"`
+	VarNames     []string `yaml:"x-enum-varnames"`
+	Descriptions []string `yaml:"x-enum-descriptions,omitempty"`
+	Deprecated   []string `yaml:"x-enum-deprecated,omitempty"`
+	GoType       string   `yaml:"x-go-type"`
+}
+
+// jsonSchemaValue describes a single value in "oneOf" of the schema
+type jsonSchemaValue struct {
+	Const       any    `json:"const"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
+}
+
+// SetGenerateJSONSchema enables or disables writing a JSON Schema of the enum to <type>_enum.schema.json,
+// and its content as the StatusJSONSchema constant, e.g. to validate inbound payloads
+func (g *Generator) SetGenerateJSONSchema(v bool) { g.generateJSONSchema = v }
+
+// SetGenerateOpenAPI enables or disables writing an OpenAPI 3 component schema of the enum
+// to <type>_enum.openapi.yaml, to be referenced from API specs
+func (g *Generator) SetGenerateOpenAPI(v bool) { g.generateOpenAPI = v }
+
+// getOpenAPIFileName returns the OpenAPI schema file name for the generated file, e.g. "job_status_enum.openapi.yaml"
+func getOpenAPIFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".openapi.yaml"
+}
+
+// getSchemaFileName returns the JSON schema file name for the generated file, e.g. "job_status_enum.schema.json"
+func getSchemaFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".schema.json"
+}
+
+// renderJSONSchema returns the JSON schema of the values as they are marshaled to JSON. Descriptions and
+// deprecations are listed in "oneOf" if any value has them, "enum" lists the values in any case.
+func renderJSONSchema(data TemplateData) ([]byte, error) {
+	schema := jsonSchema{
+		Comment:      generatedComment,
+		Schema:       "https://json-schema.org/draft/2020-12/schema",
+		Title:        data.GoType,
+		Type:         "string",
+		Enum:         make([]any, 0, len(data.Values)),
+		GoType:       data.Package + "." + data.GoType,
+		GoUnderlying: data.UnderlyingType,
+	}
+	if data.MarshalNumber {
+		schema.Type = "integer"
+	}
+	for _, v := range data.Values {
+		value := encodedValue(data, v)
+		schema.Enum = append(schema.Enum, value)
+		schema.OneOf = append(schema.OneOf, jsonSchemaValue{Const: value, Title: v.PublicName,
+			Description: v.Description, Deprecated: v.Deprecated})
+	}
+	if !data.HasDescriptions && !data.HasDeprecated {
+		schema.OneOf = nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(schema); err != nil {
+		return nil, fmt.Errorf("failed to encode json schema: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// renderOpenAPI returns the OpenAPI 3 component schema of the type, keyed by the type name to be referenced
+// as "status_enum.openapi.yaml#/Status". Value names and descriptions are in the x-enum-varnames and
+// x-enum-descriptions extensions understood by OpenAPI generators.
+func renderOpenAPI(data TemplateData) ([]byte, error) {
+	schema := openAPISchema{Type: "string", GoType: data.Package + "." + data.GoType}
+	if data.MarshalNumber {
+		schema.Type, schema.Format = "integer", openAPIFormat(data.UnderlyingType)
+	}
+	for _, v := range data.Values {
+		schema.Enum = append(schema.Enum, encodedValue(data, v))
+		schema.VarNames = append(schema.VarNames, v.PublicName)
+		schema.Descriptions = append(schema.Descriptions, v.Description)
+		if v.Deprecated {
+			schema.Deprecated = append(schema.Deprecated, v.PublicName)
+		}
+	}
+	if !data.HasDescriptions {
+		schema.Descriptions = nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(openAPIHeader + "\n")
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(map[string]openAPISchema{data.GoType: schema}); err != nil {
+		return nil, fmt.Errorf("failed to encode openapi schema: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode openapi schema: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// openAPIFormat returns the OpenAPI integer format fitting the underlying type
+func openAPIFormat(underlying string) string {
+	switch underlying {
+	case "int8", "int16", "int32", "uint8", "uint16", "byte", "rune":
+		return "int32"
+	default:
+		return "int64"
+	}
+}
+
+// encodedValue returns the value as encoding/json encodes it, the name or the integer with marshal-number
+func encodedValue(data TemplateData, v Value) any {
+	switch {
+	case data.MarshalNumber:
+		return v.Index
+	case data.LowerCase:
+		return strings.ToLower(v.Name)
+	}
+	return v.Name
+}
@@ -0,0 +1,160 @@
+package generator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateJSONSchema(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	// statusActive can log in
+	statusActive
+	statusLegacy // enum:deprecated
+)
+`
+	srcDir, outDir := t.TempDir(), filepath.Join(t.TempDir(), "api")
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("jsonschema", ""))
+	require.NoError(t, gen.Generate())
+
+	schema, err := os.ReadFile(filepath.Join(outDir, "status_enum.schema.json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"$comment": "Code generated by enum generator; DO NOT EDIT.",
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"title": "Status",
+		"type": "string",
+		"enum": ["unknown", "active", "legacy"],
+		"oneOf": [
+			{"const": "unknown", "title": "StatusUnknown"},
+			{"const": "active", "title": "StatusActive", "description": "statusActive can log in"},
+			{"const": "legacy", "title": "StatusLegacy", "deprecated": true}
+		],
+		"x-go-type": "api.Status",
+		"x-go-underlying": "uint8"
+	}`, string(schema))
+	assert.True(t, isGeneratedFile(schema))
+
+	content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "const StatusJSONSchema = `"+string(schema)+"`")
+
+	t.Run("marshal number", func(t *testing.T) {
+		gen.SetMarshalNumber(true)
+		defer gen.SetMarshalNumber(false)
+		require.NoError(t, gen.Generate())
+		schema, err := os.ReadFile(filepath.Join(outDir, "status_enum.schema.json"))
+		require.NoError(t, err)
+		var res struct {
+			Type string `json:"type"`
+			Enum []any  `json:"enum"`
+		}
+		require.NoError(t, json.Unmarshal(schema, &res))
+		assert.Equal(t, "integer", res.Type)
+		assert.Equal(t, []any{0.0, 1.0, 2.0}, res.Enum)
+	})
+
+	t.Run("combined", func(t *testing.T) {
+		require.NoError(t, GenerateCombined("enums.go", gen))
+		assert.FileExists(t, filepath.Join(outDir, "status_enum.schema.json"))
+		assert.NoFileExists(t, filepath.Join(outDir, "status_enum.go"))
+		stale, err := CheckCombined("enums.go", gen)
+		require.NoError(t, err)
+		assert.Empty(t, stale)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		gen.SetGenerateJSONSchema(false)
+		require.NoError(t, gen.Generate())
+		assert.NoFileExists(t, filepath.Join(outDir, "status_enum.schema.json"), "stale schema is removed")
+		content, err := os.ReadFile(filepath.Join(outDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "JSONSchema")
+
+		// files with the same name not written by the generator are kept
+		other := filepath.Join(outDir, "status_enum.schema.json")
+		require.NoError(t, os.WriteFile(other, []byte(`{"type": "string"}`), 0o600))
+		require.NoError(t, gen.Generate())
+		assert.FileExists(t, other)
+	})
+}
+
+func TestGenerateOpenAPI(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	// statusActive can log in
+	statusActive
+	statusOn // enum:deprecated
+)
+`
+	srcDir, outDir := t.TempDir(), filepath.Join(t.TempDir(), "api")
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("openapi", ""))
+	require.NoError(t, gen.Generate())
+
+	spec, err := os.ReadFile(filepath.Join(outDir, "status_enum.openapi.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, `# Code generated by enum generator; DO NOT EDIT.
+Status:
+  type: string
+  enum:
+    - unknown
+    - active
+    - "on"
+  x-enum-varnames:
+    - StatusUnknown
+    - StatusActive
+    - StatusOn
+  x-enum-descriptions:
+    - ""
+    - statusActive can log in
+    - ""
+  x-enum-deprecated:
+    - StatusOn
+  x-go-type: api.Status
+`, string(spec))
+	assert.True(t, isGeneratedFile(spec))
+
+	t.Run("marshal number", func(t *testing.T) {
+		gen.SetMarshalNumber(true)
+		defer gen.SetMarshalNumber(false)
+		require.NoError(t, gen.Generate())
+		spec, err := os.ReadFile(filepath.Join(outDir, "status_enum.openapi.yaml"))
+		require.NoError(t, err)
+		assert.Contains(t, string(spec), "  type: integer\n  format: int32\n  enum:\n    - 0\n    - 1\n    - 2\n")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		gen.SetGenerateOpenAPI(false)
+		require.NoError(t, gen.Generate())
+		assert.NoFileExists(t, filepath.Join(outDir, "status_enum.openapi.yaml"), "stale schema is removed")
+	})
+
+	assert.Equal(t, "int32", openAPIFormat("uint16"))
+	assert.Equal(t, "int64", openAPIFormat("uint32"))
+	assert.Equal(t, "int64", openAPIFormat(""))
+}
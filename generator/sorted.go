@@ -0,0 +1,34 @@
+package generator
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// SetGenerateSorted sets the flag to generate StatusIterByValue, StatusIterByName and StatusIterReverse,
+// iterating over the values in numeric order, in alphabetical order of names and in reverse declaration order
+func (g *Generator) SetGenerateSorted(v bool) { g.generateSorted = v }
+
+// sortedNames returns public names of the values ordered by value, numeric or the string of string-backed
+// enums, and by name, case-insensitively. The order is computed at generation time, so iterating doesn't sort,
+// values with equal keys keep declaration order.
+func sortedNames(values []Value, stringBacked bool) (byValue, byName []string) {
+	sorted := slices.Clone(values)
+	slices.SortStableFunc(sorted, func(a, b Value) int {
+		if stringBacked {
+			return strings.Compare(a.Name, b.Name)
+		}
+		return cmp.Compare(a.Index, b.Index)
+	})
+	for _, v := range sorted {
+		byValue = append(byValue, v.PublicName)
+	}
+	slices.SortStableFunc(sorted, func(a, b Value) int {
+		return cmp.Or(strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)), strings.Compare(a.Name, b.Name))
+	})
+	for _, v := range sorted {
+		byName = append(byName, v.PublicName)
+	}
+	return byValue, byName
+}
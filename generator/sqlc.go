@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetGenerateNull enables or disables generation of the Null<Type> wrapper with a Valid flag, implementing
+// driver.Valuer, sql.Scanner and JSON marshaling, so NULL doesn't turn into the zero value of the enum
+func (g *Generator) SetGenerateNull(v bool) { g.generateNull = v }
+
+// SetGenerateSQLC enables or disables writing sqlc type overrides binding database columns to the enum
+// to <type>_enum.sqlc.yaml, along with SQL interfaces sqlc-generated code stores and reads the values with
+func (g *Generator) SetGenerateSQLC(v bool) { g.generateSQLC = v }
+
+// getSQLCFileName returns the sqlc overrides file name for the generated file, e.g. "job_status_enum.sqlc.yaml"
+func getSQLCFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".sqlc.yaml"
+}
+
+// sqlInterfaces reports whether the SQL Valuer and Scanner are generated, requested directly or needed
+// by the ent or sqlc integrations, or by the Null<Type> wrapper
+func (g *Generator) sqlInterfaces() bool {
+	return g.generateSQL || g.generateEnt || g.generateSQLC || g.generateNull
+}
+
+// renderSQLC returns sqlc overrides of the Go generator mapping the database type of the same name as the
+// type in snake case, the Postgres enum type of -pg-enum, to the enum, and nullable columns to a pointer to it,
+// or to the Null<Type> wrapper if generated
+func renderSQLC(data TemplateData, importPath string) []byte {
+	typeName := data.GoType
+	var sb strings.Builder
+	sb.WriteString(openAPIHeader + "\n") // the same header as OpenAPI schemas, to be recognized as generated
+	sb.WriteString("# Merge into overrides of the go generator in sqlc.yaml. For text columns, override them by name:\n")
+	fmt.Fprintf(&sb, "#   - column: table.%s\n", data.SQLTypeName)
+	fmt.Fprintf(&sb, "#     go_type: {import: %s, type: %s}\n", importPath, typeName)
+	sb.WriteString("overrides:\n")
+	for _, nullable := range []bool{false, true} {
+		fmt.Fprintf(&sb, "  - db_type: %s\n", data.SQLTypeName)
+		if nullable {
+			sb.WriteString("    nullable: true\n")
+		}
+		sb.WriteString("    go_type:\n")
+		fmt.Fprintf(&sb, "      import: %s\n", importPath)
+		switch {
+		case nullable && data.GenerateNull:
+			fmt.Fprintf(&sb, "      type: Null%s\n", typeName)
+		case nullable:
+			fmt.Fprintf(&sb, "      type: %s\n", typeName)
+			sb.WriteString("      pointer: true\n")
+		default:
+			fmt.Fprintf(&sb, "      type: %s\n", typeName)
+		}
+	}
+	return []byte(sb.String())
+}
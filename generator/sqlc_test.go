@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestGenerateSQLC(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive
+)
+`
+	srcDir, root := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/app\n\ngo 1.23\n"), 0o600))
+	outDir := filepath.Join(root, "enums")
+
+	gen, err := New("jobStatus", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.SetOption("sqlc", ""))
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "func (e JobStatus) Value() (driver.Value, error) {", "sqlc implies sql")
+	assert.Contains(t, string(content), "func (e *JobStatus) Scan(value interface{}) error {")
+
+	data, err := os.ReadFile(filepath.Join(outDir, "job_status_enum.sqlc.yaml"))
+	require.NoError(t, err)
+	assert.True(t, isGeneratedFile(data))
+	assert.Contains(t, string(data), "#   - column: table.job_status\n#     go_type: {import: example.com/app/enums, type: JobStatus}\n")
+
+	type goType struct {
+		Import  string `yaml:"import"`
+		Type    string `yaml:"type"`
+		Pointer bool   `yaml:"pointer"`
+	}
+	var cfg struct {
+		Overrides []struct {
+			DBType   string `yaml:"db_type"`
+			Nullable bool   `yaml:"nullable"`
+			GoType   goType `yaml:"go_type"`
+		} `yaml:"overrides"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &cfg))
+	require.Len(t, cfg.Overrides, 2)
+	assert.Equal(t, "job_status", cfg.Overrides[0].DBType)
+	assert.False(t, cfg.Overrides[0].Nullable)
+	assert.Equal(t, goType{Import: "example.com/app/enums", Type: "JobStatus"}, cfg.Overrides[0].GoType)
+	assert.True(t, cfg.Overrides[1].Nullable)
+	assert.Equal(t, goType{Import: "example.com/app/enums", Type: "JobStatus", Pointer: true}, cfg.Overrides[1].GoType)
+
+	gen.SetGenerateNull(true)
+	require.NoError(t, gen.Generate())
+	data, err = os.ReadFile(filepath.Join(outDir, "job_status_enum.sqlc.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(data, &cfg))
+	assert.Equal(t, goType{Import: "example.com/app/enums", Type: "NullJobStatus"}, cfg.Overrides[1].GoType,
+		"nullable columns use the wrapper")
+
+	gen.SetGenerateSQLC(false)
+	require.NoError(t, gen.Generate())
+	assert.NoFileExists(t, filepath.Join(outDir, "job_status_enum.sqlc.yaml"), "stale file is removed")
+}
@@ -0,0 +1,44 @@
+package generator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SetStringCase sets how names of the values are derived from multi-word constant names, e.g. for
+// statusInProgress: "snake" for in_progress, "kebab" for in-progress, "screaming" for IN_PROGRESS,
+// "camel" for inProgress, or "as-is" for InProgress, the default. Parsing accepts the names in any case.
+// Names pinned with enum:name= and values of string-backed enums are used as they are.
+func (g *Generator) SetStringCase(c string) { g.stringCase = c }
+
+// checkStringCase checks the string case is known and doesn't contradict lower case names
+func (g *Generator) checkStringCase() error {
+	if !slices.Contains([]string{"", "as-is", "snake", "kebab", "screaming", "camel"}, g.stringCase) {
+		return fmt.Errorf("invalid string case %q, expected snake, kebab, screaming, camel or as-is", g.stringCase)
+	}
+	if g.lowerCase && (g.stringCase == "screaming" || g.stringCase == "camel") {
+		return fmt.Errorf("string case %s can't be used with lower", g.stringCase)
+	}
+	return nil
+}
+
+// caseName returns the name in the string case, split into words like file names, initialisms included
+func (g *Generator) caseName(name string) string {
+	if g.stringCase == "" || g.stringCase == "as-is" || name == "" {
+		return name
+	}
+	words := g.splitWords(name)
+	switch g.stringCase {
+	case "snake":
+		return strings.ToLower(strings.Join(words, "_"))
+	case "kebab":
+		return strings.ToLower(strings.Join(words, "-"))
+	case "screaming":
+		return strings.ToUpper(strings.Join(words, "_"))
+	case "camel":
+		words[0] = strings.ToLower(words[0])
+		return strings.Join(words, "")
+	}
+	return name
+}
@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaseName(t *testing.T) {
+	tests := []struct {
+		name      string
+		snake     string
+		kebab     string
+		screaming string
+		camel     string
+	}{
+		{"Active", "active", "active", "ACTIVE", "active"},
+		{"InProgress", "in_progress", "in-progress", "IN_PROGRESS", "inProgress"},
+		{"HTTPFailed", "http_failed", "http-failed", "HTTP_FAILED", "httpFailed"},
+		{"Level2Retry", "level2_retry", "level2-retry", "LEVEL2_RETRY", "level2Retry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for c, want := range map[string]string{"snake": tt.snake, "kebab": tt.kebab, "screaming": tt.screaming,
+				"camel": tt.camel, "as-is": tt.name, "": tt.name} {
+				g := &Generator{stringCase: c}
+				assert.Equal(t, want, g.caseName(tt.name), c)
+			}
+		})
+	}
+
+	t.Run("initialisms", func(t *testing.T) {
+		g := &Generator{stringCase: "snake"}
+		g.SetInitialisms([]string{"SKU"})
+		assert.Equal(t, "skus_missing", g.caseName("SKUsMissing"))
+	})
+}
+
+func TestGenerateStringCase(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusInProgress
+	jobStatusDone // enum:name=Finished
+)
+`
+	srcDir := filepath.Join(t.TempDir(), "test")
+	require.NoError(t, os.Mkdir(srcDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	newGen := func(t *testing.T, stringCase string) *Generator {
+		gen, err := New("jobStatus", srcDir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		require.NoError(t, gen.SetOption("string-case", stringCase))
+		return gen
+	}
+
+	gen := newGen(t, "snake")
+	require.NoError(t, gen.Generate())
+	content, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `JobStatusInProgress = JobStatus{name: "in_progress", value: 1}`)
+	assert.Contains(t, string(content), `JobStatusDone       = JobStatus{name: "Finished", value: 2}`, "pinned names are kept")
+	assert.Contains(t, string(content), `"in_progress": JobStatusInProgress,`)
+
+	t.Run("errors", func(t *testing.T) {
+		assert.EqualError(t, newGen(t, "pascal").Generate(),
+			`invalid string case "pascal", expected snake, kebab, screaming, camel or as-is`)
+
+		gen := newGen(t, "screaming")
+		gen.SetLowerCase(true)
+		assert.EqualError(t, gen.Generate(), "string case screaming can't be used with lower")
+	})
+
+	t.Run("alias conflict", func(t *testing.T) {
+		dir := t.TempDir()
+		code := src + "\nconst jobStatusRunning jobStatus = 3 // enum:alias=in_progress\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(code), 0o600))
+		gen, err := New("jobStatus", dir)
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(dir))
+		gen.SetStringCase("snake")
+		assert.ErrorContains(t, gen.Generate(), `alias "in_progress"`)
+	})
+}
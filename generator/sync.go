@@ -0,0 +1,285 @@
+package generator
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// syncHeader marks definition files written from a manifest, they are not removed as stale generated files
+const syncHeader = "// Code generated by enum sync from a shared manifest; DO NOT EDIT."
+
+// syncTimeout limits requests to the shared constants service
+const syncTimeout = 30 * time.Second
+
+// ErrManifestNotFound is returned by LoadManifest if the manifest file or URL doesn't exist
+var ErrManifestNotFound = errors.New("manifest not found")
+
+// Manifest describes enum definitions independently of Go source, to share business enums between
+// repositories through an HTTP endpoint or a file in a git-hosted registry
+type Manifest struct {
+	Types []TypeManifest `json:"types"`
+
+	header string // header of written definitions, syncHeader if empty
+}
+
+// TypeManifest describes one enum type of the manifest
+type TypeManifest struct {
+	Type       string          `json:"type"`                 // private type name, e.g. "status"
+	Underlying string          `json:"underlying,omitempty"` // underlying type, e.g. "uint8", "int" if empty
+	Values     []ValueManifest `json:"values"`               // values in declaration order
+}
+
+// ValueManifest describes one enum value of the manifest
+type ValueManifest struct {
+	Name    string          `json:"name"`              // private constant name, e.g. "statusActive"
+	Value   json.RawMessage `json:"value"`             // JSON number, or string for string-backed types
+	String  string          `json:"string,omitempty"`  // string form pinned with enum:name=
+	Aliases []string        `json:"aliases,omitempty"` // parsing aliases
+	Comment string          `json:"comment,omitempty"` // doc comment of the constant
+
+	Deprecated  bool   `json:"deprecated,omitempty"`  // value is marked with enum:deprecated
+	Deprecation string `json:"deprecation,omitempty"` // optional deprecation reason
+}
+
+// Manifest returns the manifest of the parsed type. Parse must be called first.
+func (g *Generator) Manifest() TypeManifest {
+	res := TypeManifest{Type: g.Type, Underlying: g.underlyingType}
+	for _, v := range g.buildValues() {
+		value := json.RawMessage(strconv.Itoa(v.Index))
+		if g.stringBacked() {
+			value, _ = json.Marshal(v.Name) // string-backed names are the values
+		}
+		vm := ValueManifest{Name: v.PrivateName, Value: value, Aliases: v.Aliases, Comment: v.Comment,
+			Deprecated: v.Deprecated, Deprecation: v.Deprecation}
+		if cv := g.values[v.PrivateName]; cv != nil {
+			vm.String = cv.name
+		}
+		res.Values = append(res.Values, vm)
+	}
+	return res
+}
+
+// LoadManifest reads the manifest from an http(s) URL or a file path and validates it
+func LoadManifest(src string) (*Manifest, error) {
+	var data []byte
+	if isURL(src) {
+		client := &http.Client{Timeout: syncTimeout}
+		resp, err := client.Get(src) //nolint:noctx // one-off request with client timeout
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch manifest: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrManifestNotFound, src)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to fetch manifest from %s: status %s", src, resp.Status)
+		}
+		if data, err = io.ReadAll(resp.Body); err != nil {
+			return nil, fmt.Errorf("failed to read manifest: %w", err)
+		}
+	} else {
+		var err error
+		data, err = os.ReadFile(src) //nolint:gosec // manifest path is provided by the user
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", ErrManifestNotFound, src)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest: %w", err)
+		}
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", src, err)
+	}
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", src, err)
+	}
+	return &m, nil
+}
+
+// Merge adds the types to the manifest, replacing types with the same name and keeping the others
+func (m *Manifest) Merge(types ...TypeManifest) {
+	for _, tm := range types {
+		idx := slices.IndexFunc(m.Types, func(t TypeManifest) bool { return t.Type == tm.Type })
+		if idx < 0 {
+			m.Types = append(m.Types, tm)
+			continue
+		}
+		m.Types[idx] = tm
+	}
+}
+
+// Push writes the manifest to an http(s) URL with a PUT request, or to a file path
+func (m *Manifest) Push(dst string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	data = append(data, '\n')
+	if !isURL(dst) {
+		if err := os.WriteFile(dst, data, 0o644); err != nil { //nolint:gosec // manifest is shared on purpose
+			return fmt.Errorf("failed to write manifest: %w", err)
+		}
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodPut, dst, bytes.NewReader(data)) //nolint:noctx // client timeout is set
+	if err != nil {
+		return fmt.Errorf("failed to make manifest request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{Timeout: syncTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to push manifest: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to push manifest to %s: status %s", dst, resp.Status)
+	}
+	return nil
+}
+
+// WriteSource writes Go definitions of the manifest types to w, a type declaration and a const block
+// per type, ready for the generator. Only the listed types are written, all of them if types is empty.
+func (m *Manifest) WriteSource(w io.Writer, pkg string, types []string) error {
+	for _, t := range types {
+		if !slices.ContainsFunc(m.Types, func(tm TypeManifest) bool { return tm.Type == t }) {
+			return fmt.Errorf("type %s not found in manifest", t)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(cmp.Or(m.header, syncHeader) + "\n\n")
+	fmt.Fprintf(&buf, "package %s\n", pkg)
+	for _, tm := range m.Types {
+		if len(types) > 0 && !slices.Contains(types, tm.Type) {
+			continue
+		}
+		fmt.Fprintf(&buf, "\ntype %s %s\n\nconst (\n", tm.Type, cmp.Or(tm.Underlying, "int"))
+		for _, v := range tm.Values {
+			if v.Comment != "" {
+				fmt.Fprintf(&buf, "// %s\n", v.Comment)
+			}
+			fmt.Fprintf(&buf, "%s %s = %s", v.Name, tm.Type, manifestLiteral(v.Value))
+			var directives []string
+			if len(v.Aliases) > 0 {
+				directives = append(directives, "enum:alias="+strings.Join(v.Aliases, ","))
+			}
+			if v.String != "" {
+				directives = append(directives, "enum:name="+v.String)
+			}
+			if v.Deprecated {
+				directives = append(directives, strings.TrimSuffix("enum:deprecated="+v.Deprecation, "="))
+			}
+			if len(directives) > 0 {
+				fmt.Fprintf(&buf, " // %s", strings.Join(directives, " "))
+			}
+			buf.WriteString("\n")
+		}
+		buf.WriteString(")\n")
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format definitions: %w", err)
+	}
+	if _, err := w.Write(src); err != nil {
+		return fmt.Errorf("failed to write definitions: %w", err)
+	}
+	return nil
+}
+
+// validate checks that type and value names are identifiers and values are integers or strings
+func (m *Manifest) validate() error {
+	var errs []error
+	for _, tm := range m.Types {
+		if _, err := New(tm.Type, ""); err != nil {
+			errs = append(errs, fmt.Errorf("type %q: %w", tm.Type, err))
+			continue
+		}
+		if tm.Underlying != "" && (!isValidGoIdentifier(tm.Underlying) || token.IsKeyword(tm.Underlying)) {
+			errs = append(errs, fmt.Errorf("type %s: invalid underlying type %q", tm.Type, tm.Underlying))
+		}
+		for _, v := range tm.Values {
+			if !strings.HasPrefix(v.Name, tm.Type) || !isValidGoIdentifier(v.Name) || token.IsKeyword(v.Name) {
+				errs = append(errs, fmt.Errorf("type %s: invalid value name %q, expected identifier prefixed with the type", tm.Type, v.Name))
+			}
+			if manifestLiteral(v.Value) == "" {
+				errs = append(errs, fmt.Errorf("type %s: invalid value %s of %s, expected integer or string", tm.Type, v.Value, v.Name))
+			}
+			if strings.ContainsAny(v.String, " \t\n\"\\") {
+				errs = append(errs, fmt.Errorf("type %s: invalid string %q of %s", tm.Type, v.String, v.Name))
+			}
+			for _, alias := range v.Aliases {
+				if alias == "" || strings.ContainsAny(alias, ", \t\n") {
+					errs = append(errs, fmt.Errorf("type %s: invalid alias %q of %s", tm.Type, alias, v.Name))
+				}
+			}
+			if strings.Contains(v.Comment, "\n") {
+				errs = append(errs, fmt.Errorf("type %s: comment of %s must be a single line", tm.Type, v.Name))
+			}
+			if strings.Contains(v.Deprecation, "\n") {
+				errs = append(errs, fmt.Errorf("type %s: deprecation of %s must be a single line", tm.Type, v.Name))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// manifestLiteral returns the Go literal of a manifest value, empty if it's neither an integer nor a string
+func manifestLiteral(value json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return strconv.Quote(s)
+	}
+	if _, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+		return string(value)
+	}
+	return ""
+}
+
+// DirPackage returns the package name of Go files in the directory, or the directory name if there are
+// none, e.g. to write definitions pulled from a manifest into the right package
+func DirPackage(dir string) (string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", dir, err)
+	}
+	for _, name := range sortedKeys(pkgs) {
+		if !strings.HasSuffix(name, "_test") {
+			return name, nil
+		}
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve %s: %w", dir, err)
+	}
+	name := filepath.Base(abs)
+	if !isValidGoIdentifier(name) || token.IsKeyword(name) {
+		return "", fmt.Errorf("can't derive package name from directory %s", abs)
+	}
+	return name, nil
+}
+
+// isURL reports whether the location is an http(s) URL rather than a file path
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestRoundTrip(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	// statusActive can log in
+	statusActive
+	statusReadWrite // enum:alias=rw,read-write
+	statusInProgress // enum:name=in-progress
+	statusLegacy // enum:deprecated=use statusActive
+)
+
+type role string
+
+const (
+	roleAdmin role = "admin"
+	roleUser  role = "user"
+)
+`
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "types.go"), []byte(src), 0o600))
+
+	var m Manifest
+	for _, typeName := range []string{"status", "role"} {
+		gen, err := New(typeName, "")
+		require.NoError(t, err)
+		require.NoError(t, gen.Parse(srcDir))
+		m.Merge(gen.Manifest())
+	}
+	require.Len(t, m.Types, 2)
+	assert.Equal(t, "uint8", m.Types[0].Underlying)
+	assert.Equal(t, ValueManifest{Name: "statusActive", Value: []byte("1"), Comment: "statusActive can log in"}, m.Types[0].Values[1])
+	assert.Equal(t, []string{"rw", "read-write"}, m.Types[0].Values[2].Aliases)
+	assert.Equal(t, "in-progress", m.Types[0].Values[3].String)
+	assert.True(t, m.Types[0].Values[4].Deprecated)
+	assert.Equal(t, "use statusActive", m.Types[0].Values[4].Deprecation)
+	assert.JSONEq(t, `"admin"`, string(m.Types[1].Values[0].Value))
+
+	// push to a file and load back
+	path := filepath.Join(t.TempDir(), "enums.json")
+	require.NoError(t, m.Push(path))
+	loaded, err := LoadManifest(path)
+	require.NoError(t, err)
+	assert.Equal(t, m.Types[0].Values, loaded.Types[0].Values)
+
+	var buf bytes.Buffer
+	require.NoError(t, loaded.WriteSource(&buf, "shared", []string{"status"}))
+	assert.Equal(t, syncHeader+`
+
+package shared
+
+type status uint8
+
+const (
+	statusUnknown status = 0
+	// statusActive can log in
+	statusActive     status = 1
+	statusReadWrite  status = 2 // enum:alias=rw,read-write
+	statusInProgress status = 3 // enum:name=in-progress
+	statusLegacy     status = 4 // enum:deprecated=use statusActive
+)
+`, buf.String())
+
+	// definitions written from the manifest are parsed back to the same values
+	outDir := t.TempDir()
+	buf.Reset()
+	require.NoError(t, loaded.WriteSource(&buf, "shared", nil))
+	require.NoError(t, os.WriteFile(filepath.Join(outDir, "enums_shared.go"), buf.Bytes(), 0o600))
+	gen, err := New("role", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(outDir))
+	assert.Equal(t, m.Types[1], gen.Manifest())
+
+	require.EqualError(t, loaded.WriteSource(&buf, "shared", []string{"color"}), "type color not found in manifest")
+
+	// merge replaces types with the same name
+	m.Merge(TypeManifest{Type: "status", Values: []ValueManifest{{Name: "statusNew", Value: []byte("5")}}})
+	require.Len(t, m.Types, 2)
+	assert.Equal(t, "statusNew", m.Types[0].Values[0].Name)
+}
+
+func TestManifestHTTP(t *testing.T) {
+	var stored []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path != "/enums.json":
+			w.WriteHeader(http.StatusForbidden)
+		case r.Method == http.MethodPut:
+			stored, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		case stored == nil:
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			_, _ = w.Write(stored)
+		}
+	}))
+	defer ts.Close()
+
+	_, err := LoadManifest(ts.URL + "/enums.json")
+	require.ErrorIs(t, err, ErrManifestNotFound)
+
+	m := &Manifest{Types: []TypeManifest{{Type: "status", Values: []ValueManifest{{Name: "statusActive", Value: []byte("1")}}}}}
+	require.NoError(t, m.Push(ts.URL+"/enums.json"))
+	loaded, err := LoadManifest(ts.URL + "/enums.json")
+	require.NoError(t, err)
+	assert.Equal(t, m, loaded)
+
+	err = m.Push(ts.URL + "/other.json")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 403 Forbidden")
+}
+
+func TestLoadManifestInvalid(t *testing.T) {
+	tests := []struct {
+		name, content, err string
+	}{
+		{"bad json", `{"types": [}`, "failed to parse manifest"},
+		{"type name", `{"types": [{"type": "Status"}]}`, `type "Status": invalid type name "Status": first letter must be lowercase`},
+		{"value name", `{"types": [{"type": "status", "values": [{"name": "active", "value": 1}]}]}`,
+			`type status: invalid value name "active", expected identifier prefixed with the type`},
+		{"value", `{"types": [{"type": "status", "values": [{"name": "statusActive", "value": 1.5}]}]}`,
+			"type status: invalid value 1.5 of statusActive, expected integer or string"},
+		{"string", `{"types": [{"type": "status", "values": [{"name": "statusActive", "value": 1, "string": "a b"}]}]}`,
+			`type status: invalid string "a b" of statusActive`},
+		{"alias", `{"types": [{"type": "status", "values": [{"name": "statusActive", "value": 1, "aliases": ["a b"]}]}]}`,
+			`type status: invalid alias "a b" of statusActive`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "enums.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+			_, err := LoadManifest(path)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	_, err := LoadManifest(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorIs(t, err, ErrManifestNotFound)
+}
+
+func TestDirPackage(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a_test.go"), []byte("package shared_test\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("package shared\n"), 0o600))
+	pkg, err := DirPackage(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "shared", pkg)
+
+	empty := filepath.Join(t.TempDir(), "billing")
+	require.NoError(t, os.Mkdir(empty, 0o700))
+	pkg, err = DirPackage(empty)
+	require.NoError(t, err)
+	assert.Equal(t, "billing", pkg)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/go-pkgz/testutils/containers"
@@ -167,3 +168,42 @@ func TestGeneratedEnumWithJSON(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid status")
 }
+
+func TestGeneratedEnumAsJSONMapKey(t *testing.T) {
+	m := map[Status]int{StatusPending: 1, StatusActive: 2, StatusUnknown: 3}
+
+	// encoding/json uses MarshalText for keys and sorts them alphabetically
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, `{"active":2,"pending":1,"unknown":3}`, string(data))
+
+	// generated helper keeps declaration order
+	data, err = MarshalStatusMapJSON(m)
+	require.NoError(t, err)
+	assert.Equal(t, `{"unknown":3,"active":2,"pending":1}`, string(data))
+
+	// keys are decoded with UnmarshalText, case-insensitive
+	var decoded map[Status]int
+	require.NoError(t, json.Unmarshal([]byte(`{"ACTIVE":2,"pending":1,"Unknown":3}`), &decoded))
+	assert.Equal(t, m, decoded)
+
+	err = json.Unmarshal([]byte(`{"bad":1}`), &decoded)
+	require.Error(t, err)
+
+	_, err = MarshalStatusMapJSON(map[Status]int{{}: 1})
+	require.Error(t, err)
+
+	data, err = MarshalStatusMapJSON[int](nil)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestGeneratedEnumGoString(t *testing.T) {
+	assert.Equal(t, "StatusActive", fmt.Sprintf("%#v", StatusActive))
+	assert.Equal(t, "PriorityHigh", PriorityHigh.GoString())
+	assert.Equal(t, `Status{name: "", value: 0}`, Status{}.GoString())
+
+	// nested values print as constants too
+	job := struct{ Status Status }{Status: StatusPending}
+	assert.Equal(t, "struct { Status integration.Status }{Status:StatusPending}", fmt.Sprintf("%#v", job))
+}
@@ -1,6 +1,6 @@
 package integration
 
-//go:generate ../../../../enum -type=status -lower -sql -bson -yaml
+//go:generate ../../../../enum -type=status -lower -sql -bson -yaml -jsonmap
 
 type status uint8
 
@@ -4,7 +4,7 @@ type repeatValues uint8
 
 const (
 	repeatValuesFirst  repeatValues = 10
-	repeatValuesSecond repeatValues // This should repeat the value 10
+	repeatValuesSecond              // This should repeat the value 10
 	repeatValuesThird  repeatValues = 20
-	repeatValuesFourth repeatValues // This should repeat the value 20
+	repeatValuesFourth              // This should repeat the value 20
 )
@@ -0,0 +1,21 @@
+package generator
+
+import "strings"
+
+// SetTrimPrefix sets a prefix removed after the type name from names and public constants, e.g. "State"
+// for statusStateActive named "Active" with the StatusActive constant. Constants without the prefix
+// are kept as they are, and the prefix is removed only if it's followed by a new word.
+func (g *Generator) SetTrimPrefix(prefix string) { g.trimPrefix = prefix }
+
+// trimName returns the constant name without the trim prefix after the type name,
+// e.g. "statusActive" for "statusStateActive"
+func (g *Generator) trimName(privateName string) string {
+	if g.trimPrefix == "" {
+		return privateName
+	}
+	rest, ok := strings.CutPrefix(strings.TrimPrefix(privateName, g.Type), titleCaser.String(g.trimPrefix))
+	if !ok || rest == "" || !endsWord(rest) {
+		return privateName
+	}
+	return g.Type + rest
+}
@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrimName(t *testing.T) {
+	g := &Generator{Type: "status", trimPrefix: "state"}
+	assert.Equal(t, "statusActive", g.trimName("statusStateActive"))
+	assert.Equal(t, "status2", g.trimName("statusState2"))
+	assert.Equal(t, "statusStatement", g.trimName("statusStatement"), "prefix followed by a lowercase letter")
+	assert.Equal(t, "statusState", g.trimName("statusState"), "nothing left after the prefix")
+	assert.Equal(t, "statusActive", g.trimName("statusActive"))
+
+	g.trimPrefix = ""
+	assert.Equal(t, "statusStateActive", g.trimName("statusStateActive"))
+}
+
+func TestGenerateTrimPrefix(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusStateUnknown jobStatus = iota
+	jobStatusStateInProgress
+	jobStatusStatePaused // enum:alias=halted
+	jobStatusStatement
+)
+`
+	srcDir := filepath.Join(t.TempDir(), "test")
+	require.NoError(t, os.Mkdir(srcDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("jobStatus", srcDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.SetOption("trim-prefix", "State"))
+	gen.SetFallback("unknown")
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		`JobStatusUnknown    = JobStatus{name: "Unknown", value: 0}`,
+		`JobStatusInProgress = JobStatus{name: "InProgress", value: 1}`,
+		`JobStatusStatement  = JobStatus{name: "Statement", value: 3}`,
+		`"inprogress": JobStatusInProgress,`,
+		`"halted":     JobStatusPaused,`,
+		"var _ jobStatus = jobStatusStateInProgress\n",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+	assert.NotContains(t, string(content), "JobStatusStateInProgress")
+
+	t.Run("duplicate", func(t *testing.T) {
+		dir := t.TempDir()
+		code := src + "\nconst jobStatusPaused jobStatus = 4\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(code), 0o600))
+		g, err := New("jobStatus", dir)
+		require.NoError(t, err)
+		require.NoError(t, g.Parse(dir))
+		g.SetTrimPrefix("State")
+		assert.ErrorContains(t, g.Generate(), `duplicate name "Paused"`)
+	})
+}
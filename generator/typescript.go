@@ -0,0 +1,70 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// SetTypeScriptOut sets the directory to write TypeScript definitions of the enum to, e.g. a frontend
+// source directory, nothing is written if empty. Relative paths are resolved against the working directory.
+func (g *Generator) SetTypeScriptOut(dir string) {
+	g.tsOut = dir
+	if abs, err := filepath.Abs(dir); err == nil && dir != "" {
+		g.tsOut = abs
+	}
+}
+
+// getTypeScriptFileName returns the TypeScript file name for the generated file, e.g. "job_status_enum.ts"
+func getTypeScriptFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + ".ts"
+}
+
+// renderTypeScript returns TypeScript definitions of the type: a const object mapping value names to
+// the values as they are encoded to JSON, a union type of the values with the same name, and the list
+// of values in declaration order. Descriptions and deprecations become JSDoc comments.
+func renderTypeScript(data TemplateData) ([]byte, error) {
+	typeName := data.GoType
+	var sb strings.Builder
+	sb.WriteString(generatedHeader + "\n\n")
+
+	fmt.Fprintf(&sb, "/** %s values by name, as encoded to JSON */\n", typeName)
+	fmt.Fprintf(&sb, "export const %s = {\n", typeName)
+	literals := make([]string, 0, len(data.Values))
+	for _, v := range data.Values {
+		lit, err := json.Marshal(encodedValue(data, v))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode value %s: %w", v.PrivateName, err)
+		}
+		literals = append(literals, string(lit))
+
+		var doc []string
+		if v.Description != "" {
+			doc = append(doc, strings.ReplaceAll(v.Description, "*/", "*\\/"))
+		}
+		if v.Deprecated {
+			doc = append(doc, strings.TrimSpace("@deprecated "+strings.ReplaceAll(v.Deprecation, "*/", "*\\/")))
+		}
+		if len(doc) > 0 {
+			fmt.Fprintf(&sb, "  /** %s */\n", strings.Join(doc, " "))
+		}
+		fmt.Fprintf(&sb, "  %s: %s,\n", tsKey(strings.TrimPrefix(v.PublicName, typeName)), lit)
+	}
+	sb.WriteString("} as const;\n\n")
+
+	fmt.Fprintf(&sb, "/** %s is one of the %s values */\n", typeName, typeName)
+	fmt.Fprintf(&sb, "export type %s = (typeof %s)[keyof typeof %s];\n\n", typeName, typeName, typeName)
+
+	fmt.Fprintf(&sb, "/** %sValues contains all %s values in declaration order */\n", typeName, typeName)
+	fmt.Fprintf(&sb, "export const %sValues: readonly %s[] = [%s];\n", typeName, typeName, strings.Join(literals, ", "))
+	return []byte(sb.String()), nil
+}
+
+// tsKey returns the object key for the value name, quoted if it's not an ASCII identifier, e.g. "2FA"
+func tsKey(name string) string {
+	if isValidGoIdentifier(name) && !strings.ContainsFunc(name, func(r rune) bool { return r > 127 }) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTypeScript(t *testing.T) {
+	src := `package test
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	// statusActive can log in
+	statusActive
+	statusLegacy // enum:deprecated=use StatusActive
+	status2FA
+)
+`
+	srcDir, outDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+	tsDir := filepath.Join(t.TempDir(), "web", "src", "enums")
+
+	gen, err := New("status", outDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	gen.SetLowerCase(true)
+	require.NoError(t, gen.SetOption("ts-out", tsDir))
+	require.NoError(t, gen.Generate())
+
+	ts, err := os.ReadFile(filepath.Join(tsDir, "status_enum.ts"))
+	require.NoError(t, err)
+	assert.Equal(t, `// Code generated by enum generator; DO NOT EDIT.
+
+/** Status values by name, as encoded to JSON */
+export const Status = {
+  Unknown: "unknown",
+  /** statusActive can log in */
+  Active: "active",
+  /** @deprecated use StatusActive */
+  Legacy: "legacy",
+  "2FA": "2fa",
+} as const;
+
+/** Status is one of the Status values */
+export type Status = (typeof Status)[keyof typeof Status];
+
+/** StatusValues contains all Status values in declaration order */
+export const StatusValues: readonly Status[] = ["unknown", "active", "legacy", "2fa"];
+`, string(ts))
+	assert.FileExists(t, filepath.Join(outDir, "status_enum.go"))
+	require.Len(t, gen.Output(), 2)
+	assert.Equal(t, filepath.Join(tsDir, "status_enum.ts"), gen.Output()[1].Path)
+
+	t.Run("marshal number", func(t *testing.T) {
+		gen.SetMarshalNumber(true)
+		defer gen.SetMarshalNumber(false)
+		require.NoError(t, gen.Generate())
+		ts, err := os.ReadFile(filepath.Join(tsDir, "status_enum.ts"))
+		require.NoError(t, err)
+		assert.Contains(t, string(ts), "  Active: 1,\n")
+		assert.Contains(t, string(ts), "export const StatusValues: readonly Status[] = [0, 1, 2, 3];\n")
+	})
+
+	t.Run("check", func(t *testing.T) {
+		require.NoError(t, gen.Generate())
+		stale, err := gen.Check()
+		require.NoError(t, err)
+		assert.Empty(t, stale)
+
+		require.NoError(t, os.Remove(filepath.Join(tsDir, "status_enum.ts")))
+		stale, err = gen.Check()
+		require.NoError(t, err)
+		assert.Equal(t, []string{filepath.Join(tsDir, "status_enum.ts") + ": missing"}, stale)
+	})
+}
@@ -0,0 +1,26 @@
+package generator
+
+import "fmt"
+
+// SetUnexported enables or disables generation of unexported code, for enums which are implementation details
+// of the package. Exported package-level names get a lowercase first letter, e.g. parseStatus and statusValues,
+// names taken by the source, the type and its constants, get an Enum suffix after the type name, e.g. statusEnum
+// and statusEnumActive. Methods stay exported to implement interfaces.
+func (g *Generator) SetUnexported(v bool) { g.unexported = v }
+
+// checkUnexported reports options requiring exported names, used by other packages or tools
+func (g *Generator) checkUnexported() error {
+	if !g.unexported {
+		return nil
+	}
+	for _, opt := range []struct {
+		name    string
+		enabled bool
+	}{{"examples", g.generateExamples}, {"ent", g.generateEnt}, {"sqlc", g.generateSQLC}, {"gqlgen", g.generateGQLGen},
+		{"cross-package", g.crossPackage}} {
+		if opt.enabled {
+			return fmt.Errorf("unexported can't be used with %s, it needs exported names", opt.name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateUnexported(t *testing.T) {
+	src := `package test
+
+type jobStatus uint8
+
+const (
+	jobStatusUnknown jobStatus = iota
+	jobStatusActive
+)
+`
+	srcDir := filepath.Join(t.TempDir(), "test")
+	require.NoError(t, os.Mkdir(srcDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "status.go"), []byte(src), 0o600))
+
+	gen, err := New("jobStatus", srcDir)
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(srcDir))
+	require.NoError(t, gen.SetOption("unexported", ""))
+	gen.SetGenerateNull(true)
+	gen.SetGenerateTests(true)
+	require.NoError(t, gen.Generate())
+
+	content, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum.go"))
+	require.NoError(t, err)
+	for _, want := range []string{
+		"// jobStatusEnum is the type for the enum\ntype jobStatusEnum struct {",
+		"func parseJobStatus(v string) (jobStatusEnum, error) {",
+		"var jobStatusValues = []jobStatusEnum{",
+		"jobStatusEnumActive  = jobStatusEnum{name: \"Active\", value: 1}",
+		"case jobStatusEnumActive:\n\t\treturn \"jobStatusEnumActive\"",
+		"fmt.Sprintf(\"jobStatusEnum{name: %q, value: %d}\", e.name, e.value)",
+		"func (e jobStatusEnum) MarshalText() ([]byte, error) {",
+		"type nullJobStatus struct {\n\tJobStatus jobStatusEnum\n",
+		"return n.JobStatus.Value()",
+		"var _ jobStatus = jobStatusActive\n",
+	} {
+		assert.Contains(t, string(content), want)
+	}
+	assert.NotContains(t, string(content), "ParseJobStatus")
+	tests, err := os.ReadFile(filepath.Join(srcDir, "job_status_enum_test.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(tests), "parseJobStatus(")
+	assert.NotContains(t, string(tests), "ParseJobStatus(")
+
+	t.Run("regenerate", func(t *testing.T) {
+		g, err := New("jobStatus", srcDir)
+		require.NoError(t, err)
+		require.NoError(t, g.Parse(srcDir))
+		g.SetUnexported(true)
+		g.SetGenerateNull(true)
+		g.SetGenerateTests(true)
+		stale, err := g.Check()
+		require.NoError(t, err)
+		assert.Empty(t, stale, "names of generated files are not taken")
+	})
+
+	t.Run("taken name", func(t *testing.T) {
+		dir := t.TempDir()
+		code := src + "\nfunc parseJobStatus(string) jobStatus { return jobStatusUnknown }\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(code), 0o600))
+		g, err := New("jobStatus", dir)
+		require.NoError(t, err)
+		require.NoError(t, g.Parse(dir))
+		g.SetUnexported(true)
+		assert.EqualError(t, g.Generate(), "name parseJobStatus of ParseJobStatus is already declared in the package")
+	})
+
+	t.Run("exported names required", func(t *testing.T) {
+		gen.SetGenerateExamples(true)
+		assert.EqualError(t, gen.Generate(), "unexported can't be used with examples, it needs exported names")
+	})
+}
@@ -0,0 +1,302 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// usage kinds reported by FindUsages
+const (
+	UsageSwitch     = "switch"     // value used as a switch case
+	UsageComparison = "comparison" // value compared with ==, !=, <, etc.
+	UsageConversion = "conversion" // raw conversion to the enum type, e.g. status(x)
+	UsageReference  = "reference"  // any other reference to a value
+)
+
+// Usage describes a single location where the enum type or one of its values is used
+type Usage struct {
+	Pos   token.Position // location of the usage
+	Kind  string         // one of Usage* constants
+	Value string         // private constant name of the referenced value, empty for conversions
+	Line  string         // trimmed source line
+}
+
+// ModuleRoot returns the directory of the closest go.mod at or above dir.
+// If no go.mod is found, the absolute form of dir is returned.
+func ModuleRoot(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+	for d := abs; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(filepath.Join(d, "go.mod")); err == nil {
+			return d
+		}
+		if filepath.Dir(d) == d {
+			return abs
+		}
+	}
+}
+
+// FindUsages scans all Go files under root for switches, comparisons and conversions involving the enum
+// and for any other references to its values. Private names (the type and its constants) are matched only
+// in the package the enum was parsed from, public names are matched there directly and as qualified
+// selectors (e.g. pkg.StatusActive) everywhere else. Generated files, vendor, testdata and hidden
+// directories are skipped. Parse must be called first.
+func (g *Generator) FindUsages(root string) ([]Usage, error) {
+	var res []Usage
+	err := g.scanModule(root, func(s *usageScanner, path string) error {
+		found, err := s.scanFile(path)
+		res = append(res, found...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if res[i].Pos.Filename != res[j].Pos.Filename {
+			return res[i].Pos.Filename < res[j].Pos.Filename
+		}
+		if res[i].Pos.Line != res[j].Pos.Line {
+			return res[i].Pos.Line < res[j].Pos.Line
+		}
+		return res[i].Pos.Column < res[j].Pos.Column
+	})
+	return res, nil
+}
+
+// scanModule calls scan for each Go file under root with a scanner matching names of the enum values
+// in the file's package. Vendor, testdata, hidden and underscore-prefixed directories are skipped.
+func (g *Generator) scanModule(root string, scan func(s *usageScanner, path string) error) error {
+	if len(g.values) == 0 {
+		return fmt.Errorf("%w for type %s", ErrNoConstants, g.Type)
+	}
+
+	pkgDir, err := filepath.Abs(g.srcDir)
+	if err != nil {
+		return fmt.Errorf("failed to resolve source directory: %w", err)
+	}
+
+	// map both private and public names to the private constant name
+	values := g.buildValues()
+	privateNames := make(map[string]string, len(values))
+	publicNames := make(map[string]string, len(values))
+	for _, v := range values {
+		privateNames[v.PrivateName] = v.PrivateName
+		publicNames[v.PublicName] = v.PrivateName
+	}
+
+	walkErr := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		absDir, err := filepath.Abs(filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		return scan(&usageScanner{
+			typeName:     g.Type,
+			samePackage:  absDir == pkgDir,
+			values:       values,
+			privateNames: privateNames,
+			publicNames:  publicNames,
+		}, path)
+	})
+	if walkErr != nil {
+		return fmt.Errorf("failed to scan %s: %w", root, walkErr)
+	}
+	return nil
+}
+
+// usageScanner finds enum usages in a single file
+type usageScanner struct {
+	typeName     string
+	samePackage  bool              // file belongs to the enum's package
+	values       []Value           // values of the enum in declaration order
+	privateNames map[string]string // private const name -> private const name
+	publicNames  map[string]string // public const name -> private const name
+}
+
+// sourceFile is a parsed Go file with its lines, to report locations with the source line
+type sourceFile struct {
+	fset  *token.FileSet
+	file  *ast.File
+	lines []string
+}
+
+// parseSourceFile parses the Go file at path, nil is returned for generated files
+func parseSourceFile(path string) (*sourceFile, error) {
+	src, err := os.ReadFile(path) //nolint:gosec // path comes from walking the module
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if ast.IsGenerated(file) {
+		return nil, nil
+	}
+	return &sourceFile{fset: fset, file: file, lines: strings.Split(string(src), "\n")}, nil
+}
+
+// position returns the location of pos and the trimmed source line at it
+func (f *sourceFile) position(pos token.Pos) (token.Position, string) {
+	p := f.fset.Position(pos)
+	if p.Line > 0 && p.Line <= len(f.lines) {
+		return p, strings.TrimSpace(f.lines[p.Line-1])
+	}
+	return p, ""
+}
+
+// scanFile parses the file and collects usages, skipping generated files
+func (s *usageScanner) scanFile(path string) ([]Usage, error) {
+	src, err := parseSourceFile(path)
+	if err != nil || src == nil {
+		return nil, err
+	}
+
+	var res []Usage
+	add := func(pos token.Pos, kind, value string) {
+		p, line := src.position(pos)
+		res = append(res, Usage{Pos: p, Kind: kind, Value: value, Line: line})
+	}
+
+	var stack []ast.Node // parents of the current node
+	var inspect func(n ast.Node) bool
+	// descend inspects the children of node with node pushed as a parent
+	descend := func(node ast.Node, children ...ast.Node) {
+		stack = append(stack, node)
+		for _, c := range children {
+			ast.Inspect(c, inspect)
+		}
+		stack = stack[:len(stack)-1]
+	}
+	inspect = func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		switch node := n.(type) {
+		case *ast.ValueSpec:
+			// names in a declaration are definitions, not usages
+			children := make([]ast.Node, 0, len(node.Values)+1)
+			if node.Type != nil {
+				children = append(children, node.Type)
+			}
+			for _, v := range node.Values {
+				children = append(children, v)
+			}
+			descend(node, children...)
+			return false
+		case *ast.SelectorExpr:
+			if _, ok := node.X.(*ast.Ident); ok {
+				if value, ok := s.publicNames[node.Sel.Name]; ok {
+					add(node.Pos(), usageKind(stack, node), value)
+					return false
+				}
+			}
+			// the selected name is never a bare identifier, inspect only the receiver part
+			descend(node, node.X)
+			return false
+		case *ast.CallExpr:
+			// conversions in const declarations define values rather than convert them
+			if ident, ok := node.Fun.(*ast.Ident); ok && s.samePackage && ident.Name == s.typeName && len(node.Args) == 1 &&
+				!inConstDecl(stack) {
+				add(node.Pos(), UsageConversion, "")
+			}
+		case *ast.Ident:
+			if value, ok := s.matchIdent(node.Name); ok {
+				add(node.Pos(), usageKind(stack, node), value)
+			}
+		}
+		stack = append(stack, n)
+		return true
+	}
+	ast.Inspect(src.file, inspect)
+	return res, nil
+}
+
+// inConstDecl reports whether any of the parents is a const declaration
+func inConstDecl(parents []ast.Node) bool {
+	for _, p := range parents {
+		if decl, ok := p.(*ast.GenDecl); ok && decl.Tok == token.CONST {
+			return true
+		}
+	}
+	return false
+}
+
+// FindConversions returns raw conversions to the enum type, e.g. status(v), in non-generated files under root.
+// Such conversions bypass validation and can bring values not defined by the enum into the domain.
+// Conversions in const declarations are not reported. Parse must be called first.
+func (g *Generator) FindConversions(root string) ([]Usage, error) {
+	usages, err := g.FindUsages(root)
+	if err != nil {
+		return nil, err
+	}
+	var res []Usage
+	for _, u := range usages {
+		if u.Kind == UsageConversion {
+			res = append(res, u)
+		}
+	}
+	return res, nil
+}
+
+// matchIdent checks if a bare identifier refers to an enum value
+func (s *usageScanner) matchIdent(name string) (string, bool) {
+	if !s.samePackage {
+		return "", false
+	}
+	if value, ok := s.privateNames[name]; ok {
+		return value, true
+	}
+	value, ok := s.publicNames[name]
+	return value, ok
+}
+
+// usageKind classifies a value reference by its closest meaningful parent
+func usageKind(parents []ast.Node, node ast.Node) string {
+	child := node
+	for i := len(parents) - 1; i >= 0; i-- {
+		switch p := parents[i].(type) {
+		case *ast.ParenExpr:
+			child = p
+			continue
+		case *ast.BinaryExpr:
+			switch p.Op {
+			case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+				return UsageComparison
+			}
+		case *ast.CaseClause:
+			for _, e := range p.List {
+				if e == child {
+					return UsageSwitch
+				}
+			}
+		}
+		return UsageReference
+	}
+	return UsageReference
+}
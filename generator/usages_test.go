@@ -0,0 +1,148 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindUsages(t *testing.T) {
+	root := t.TempDir()
+	pkgDir := filepath.Join(root, "status")
+	otherDir := filepath.Join(root, "other")
+	require.NoError(t, os.MkdirAll(pkgDir, 0o750))
+	require.NoError(t, os.MkdirAll(otherDir, 0o750))
+
+	files := map[string]string{
+		filepath.Join(root, "go.mod"): "module example.com/app\n\ngo 1.24\n",
+		filepath.Join(pkgDir, "status.go"): `package status
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusBlocked
+)
+`,
+		filepath.Join(pkgDir, "status_enum.go"): `// Code generated by enum generator; DO NOT EDIT.
+package status
+
+var _ = statusActive
+`,
+		filepath.Join(pkgDir, "logic.go"): `package status
+
+func check(s status, v int) bool {
+	switch s {
+	case statusActive, statusBlocked:
+		return true
+	}
+	if (s == statusUnknown) {
+		return false
+	}
+	_ = status(v)
+	def := StatusActive
+	_ = def
+	return false
+}
+`,
+		filepath.Join(otherDir, "other.go"): `package other
+
+import "example.com/app/status"
+
+const statusActive = 1 // unrelated constant in a different package
+
+func use(s status.Status) bool {
+	return s != status.StatusBlocked && statusActive == 1
+}
+`,
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(pkgDir))
+
+	usages, err := gen.FindUsages(ModuleRoot(pkgDir))
+	require.NoError(t, err)
+
+	type found struct {
+		file, kind, value string
+		line              int
+	}
+	res := make([]found, 0, len(usages))
+	for _, u := range usages {
+		res = append(res, found{file: filepath.Base(u.Pos.Filename), kind: u.Kind, value: u.Value, line: u.Pos.Line})
+	}
+	assert.Equal(t, []found{
+		{file: "other.go", kind: UsageComparison, value: "statusBlocked", line: 8},
+		{file: "logic.go", kind: UsageSwitch, value: "statusActive", line: 5},
+		{file: "logic.go", kind: UsageSwitch, value: "statusBlocked", line: 5},
+		{file: "logic.go", kind: UsageComparison, value: "statusUnknown", line: 8},
+		{file: "logic.go", kind: UsageConversion, value: "", line: 11},
+		{file: "logic.go", kind: UsageReference, value: "statusActive", line: 12},
+	}, res)
+	assert.Equal(t, "return s != status.StatusBlocked && statusActive == 1", usages[0].Line)
+}
+
+func TestFindUsagesNotParsed(t *testing.T) {
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	_, err = gen.FindUsages(".")
+	require.Error(t, err)
+}
+
+func TestModuleRoot(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "a", "b")
+	require.NoError(t, os.MkdirAll(nested, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module x\n"), 0o600))
+
+	assert.Equal(t, root, ModuleRoot(nested))
+	assert.Equal(t, root, ModuleRoot(root))
+}
+
+func TestFindConversions(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		filepath.Join(root, "go.mod"): "module example.com/app\n\ngo 1.24\n",
+		filepath.Join(root, "status.go"): `package app
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusLegacy = status(10)
+)
+
+func fromDB(v int) status {
+	if v == 1 {
+		return statusActive
+	}
+	return status(v)
+}
+`,
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(name, []byte(content), 0o600))
+	}
+
+	gen, err := New("status", "")
+	require.NoError(t, err)
+	require.NoError(t, gen.Parse(root))
+
+	conversions, err := gen.FindConversions(root)
+	require.NoError(t, err)
+	require.Len(t, conversions, 1)
+	assert.Equal(t, 15, conversions[0].Pos.Line)
+	assert.Equal(t, "return status(v)", conversions[0].Line)
+
+	_, err = gen.FindConversions(filepath.Join(root, "missing"))
+	require.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"io"
+	"path/filepath"
+)
+
+// SetVerbose sets the writer of diagnostics explaining parsing decisions: files and const blocks scanned,
+// constants matched with their values, and constants skipped with the reason. Nil disables them.
+func (g *Generator) SetVerbose(w io.Writer) { g.verbose = w }
+
+// logf writes a diagnostic line, prefixed with the file and line of pos if it's valid
+func (g *Generator) logf(pos token.Pos, format string, args ...any) {
+	if g.verbose == nil {
+		return
+	}
+	if g.fset != nil && pos.IsValid() {
+		p := g.fset.Position(pos)
+		format = fmt.Sprintf("%s:%d: ", filepath.Base(p.Filename), p.Line) + format
+	}
+	fmt.Fprintf(g.verbose, format+"\n", args...)
+}
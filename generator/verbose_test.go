@@ -0,0 +1,52 @@
+package generator
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVerbose(t *testing.T) {
+	src := `package test
+
+import "math"
+
+type status uint8
+
+const (
+	statusUnknown status = iota
+	statusActive
+	_
+	statusMax        = status(math.MaxUint8)
+	statusCount  int = 3
+	otherValue       = 7
+)
+`
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "status.go"), []byte(src), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "status_tools.go"), []byte("//go:build tools\n\npackage test\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "status_enum.go"), []byte(generatedHeader+"\npackage test\n"), 0o600))
+
+	gen, err := New("status", dir)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	gen.SetVerbose(&buf)
+	require.NoError(t, gen.Parse(dir))
+	assert.Equal(t, `status_tools.go: skipped, excluded by build constraints
+status.go:1: scanning package test
+status.go:5: type status uint8 declared
+status.go:7: const block with 6 spec(s)
+status.go:8: statusUnknown matched, value 0
+status.go:9: statusActive matched, value 1
+status.go:10: _ skipped, blank identifier
+status.go:11: statusMax matched, value 255
+status.go:12: statusCount skipped, constant of type int
+status.go:13: otherValue skipped, constant of type untyped int
+status_enum.go:2: skipped, generated by enum
+3 const value(s) found for type status
+`, buf.String())
+}
@@ -2,78 +2,1137 @@
 package main
 
 import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"go/token"
+	"io"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
 
-	"github.com/go-pkgz/enum/internal/generator"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/go-pkgz/enum/exhaustive"
+	"github.com/go-pkgz/enum/generator"
 )
 
 // allow mocking os.Exit in tests
 var osExit = os.Exit
 
+// allow mocking commands run by self-update in tests
+var execCommand = exec.Command
+
 func main() {
-	typeFlag := flag.String("type", "", "type name (must be lowercase)")
-	pathFlag := flag.String("path", "", "output directory path (default: same as source)")
-	lowerFlag := flag.Bool("lower", false, "use lowercase for string representation (e.g., 'active' instead of 'Active')")
-	getterFlag := flag.Bool("getter", false, "generate GetByID function to retrieve enum by integer value (requires unique IDs)")
-	// optional integrations (all disabled by default to avoid extra deps)
-	sqlFlag := flag.Bool("sql", false, "generate SQL support (database/sql/driver.Valuer and sql.Scanner)")
-	bsonFlag := flag.Bool("bson", false, "generate MongoDB BSON support (MarshalBSONValue/UnmarshalBSONValue)")
-	yamlFlag := flag.Bool("yaml", false, "generate YAML support (gopkg.in/yaml.v3 Marshaler/Unmarshaler)")
+	// subcommands are dispatched before the generation flags are parsed
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "describe":
+			osExit(runDescribe(os.Args[2:]))
+			return
+		case "usages":
+			osExit(runUsages(os.Args[2:]))
+			return
+		case "rename":
+			osExit(runRename(os.Args[2:]))
+			return
+		case "vet":
+			osExit(runVet(os.Args[2:]))
+			return
+		case "self-update":
+			osExit(runSelfUpdate(os.Args[2:]))
+			return
+		case "sync":
+			osExit(runSync(os.Args[2:]))
+			return
+		case "import-proto":
+			osExit(runImportProto(os.Args[2:]))
+			return
+		case "scan":
+			osExit(runScan(os.Args[2:]))
+			return
+		}
+	}
+
+	typeFlag := flag.String("type", "", "type name (must be lowercase), comma-separated for multiple types")
+	allFlag := flag.Bool("all", false, "generate all types annotated with an enum: directive in the current directory")
+	opts := registerGenFlags(flag.CommandLine)
 	helpFlag := flag.Bool("help", false, "show usage")
 	versionFlag := flag.Bool("version", false, "print version")
 	flag.Parse()
 
-	// collect build info (version), new in go 1.24
-	buildInfo := "dev"
-	if info, ok := debug.ReadBuildInfo(); ok {
-		if info.Main.Version != "" {
-			buildInfo = info.Main.Version
-		}
-	}
-
 	if *helpFlag {
 		showUsage()
 		osExit(0)
 		return
 	}
 	if *versionFlag {
-		fmt.Printf("enum generator %s\n", buildInfo)
+		fmt.Printf("enum generator %s\n", toolVersion())
 		osExit(0)
 		return
 	}
 
-	gen, err := generator.New(*typeFlag, *pathFlag)
+	if code := runGeneration(*typeFlag, *allFlag, opts, showUsage); code != 0 {
+		osExit(code)
+	}
+}
+
+// runGeneration generates code for the comma-separated types, or all annotated ones, in the current directory
+// and returns the exit code. Errors are printed, with usage for invalid types.
+func runGeneration(typeList string, all bool, opts *genOptions, usage func()) int {
+	started := time.Now()
+	types := strings.Split(typeList, ",")
+	if all {
+		annotated, err := generator.AnnotatedTypes(".", parseTags(opts.tags))
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			return 1
+		}
+		if len(annotated) == 0 {
+			fmt.Printf("no types with enum: directive found\n")
+			return 1
+		}
+		types = annotated
+	}
+
+	if len(types) > 1 && opts.output != "" && !strings.Contains(opts.output, "{{") && opts.combine == "" {
+		fmt.Printf("output %q is the same for all types, use {{.Type}} in it or -combine\n", opts.output)
+		return 1
+	}
+
+	gens := make([]*generator.Generator, 0, len(types))
+	for _, typeName := range types {
+		gen, err := newGenerator(strings.TrimSpace(typeName), opts)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			usage()
+			return 1
+		}
+
+		if err := gen.Parse("."); err != nil {
+			fmt.Printf("%v\n", err)
+			return 1
+		}
+		gens = append(gens, gen)
+	}
+
+	if opts.check {
+		return checkGenerated(gens, opts)
+	}
+	units, err := generateAll(gens, opts)
+	if opts.report != "" {
+		if rerr := writeReport(opts.report, started, units, err); rerr != nil {
+			fmt.Printf("%v\n", rerr)
+			return 1
+		}
+	}
 	if err != nil {
 		fmt.Printf("%v\n", err)
-		showUsage()
-		osExit(1)
-		return
+		return 1
+	}
+	return 0
+}
+
+// toolVersion returns the version of the running generator from build info, "dev" if not available
+func toolVersion() string {
+	// collect build info (version), new in go 1.24
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	return "dev"
+}
+
+// generateAll generates code for all types, to a file per type, a combined file with -combine or to stdout.
+// Returns reports of the generated units, including the failed one.
+func generateAll(gens []*generator.Generator, opts *genOptions) ([]unitReport, error) {
+	if opts.combine != "" {
+		types := make([]string, 0, len(gens))
+		for _, gen := range gens {
+			types = append(types, gen.Type)
+		}
+		unit := unitReport{Types: types}
+		start := time.Now()
+		var err error
+		if opts.stdout {
+			err = generator.GenerateCombinedTo(os.Stdout, gens...)
+		} else if err = generator.GenerateCombined(opts.combine, gens...); err == nil {
+			unit.Files = gens[0].Output()
+		}
+		unit.DurationMS = msSince(start)
+		return []unitReport{unit}, err
+	}
+
+	units := make([]unitReport, 0, len(gens))
+	for _, gen := range gens {
+		start := time.Now()
+		err := generate(gen, opts)
+		unit := unitReport{Types: []string{gen.Type}, DurationMS: msSince(start)}
+		if err == nil && !opts.stdout {
+			unit.Files = gen.Output()
+		}
+		units = append(units, unit)
+		if err != nil {
+			return units, err
+		}
+	}
+	return units, nil
+}
+
+// runReport is the machine-readable report of a generation run, written with -report
+type runReport struct {
+	Generator  string       `json:"generator"`       // generator version
+	Started    time.Time    `json:"started"`         // start of the run
+	DurationMS float64      `json:"duration_ms"`     // duration of the whole run, parsing included
+	Units      []unitReport `json:"units"`           // generated units in order
+	Error      string       `json:"error,omitempty"` // generation error, if the run failed
+}
+
+// unitReport describes the generation of one type, or of all types combined into one file with -combine
+type unitReport struct {
+	Types      []string               `json:"types"`       // generated types
+	DurationMS float64                `json:"duration_ms"` // duration of the generation
+	Files      []generator.OutputFile `json:"files"`       // files written and removed, empty with -stdout
+}
+
+// writeReport writes the JSON report of the run to the file
+func writeReport(path string, started time.Time, units []unitReport, genErr error) error {
+	rep := runReport{Generator: toolVersion(), Started: started, DurationMS: msSince(started), Units: units}
+	if genErr != nil {
+		rep.Error = genErr.Error()
+	}
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode report: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil { //nolint:gosec // report is not sensitive
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// msSince returns the time elapsed since t in milliseconds
+func msSince(t time.Time) float64 {
+	return float64(time.Since(t).Microseconds()) / 1000
+}
+
+// checkGenerated reports generated files which are not up to date with the source and returns the exit code,
+// 1 if any file is missing, differs or is stale
+func checkGenerated(gens []*generator.Generator, opts *genOptions) int {
+	check := func() ([]string, error) {
+		if opts.combine != "" {
+			return generator.CheckCombined(opts.combine, gens...)
+		}
+		var res []string
+		for _, gen := range gens {
+			r, err := gen.Check()
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, r...)
+		}
+		return res, nil
+	}
+	outdated, err := check()
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+
+	if len(outdated) == 0 {
+		return 0
+	}
+	for _, o := range outdated {
+		fmt.Println(o)
+	}
+	fmt.Printf("%d generated file(s) not up to date, run go generate\n", len(outdated))
+	return 1
+}
+
+// genOptions holds the code generation flags
+type genOptions struct {
+	path     string
+	lower    bool
+	strCase  string
+	trim     string
+	getter   bool
+	sql      bool
+	bson     bool
+	yaml     bool
+	toml     bool
+	pgx      bool
+	pgEnum   bool
+	ddl      string
+	split    bool
+	splitTag string
+	flags    bool
+	set      bool
+	wrap     bool
+	testHelp bool
+	rapid    bool
+	tests    bool
+	examples bool
+	crossPkg bool
+	unexport bool
+	jsonmap  bool
+	enumMap  bool
+	checked  bool
+	complete bool
+	env      bool
+	swag     bool
+	format   bool
+	packed   bool
+	errVals  bool
+	fallback string
+	nullVal  string
+	nullStr  bool
+	hideDep  bool
+	i18n     string
+	schema   bool
+	openAPI  bool
+	tsOut    string
+	protoOut string
+	graphql  bool
+	redis    bool
+	null     bool
+	gqlgen   bool
+	ent      bool
+	sqlc     bool
+	jsonex   bool
+	info     bool
+	immut    bool
+	seq      bool
+	sorted   bool
+	descr    bool
+	registry bool
+	runtime  bool
+	generic  bool
+	tags     string
+	verbose  bool
+	tmpl     string
+	extras   string
+	config   string
+	combine  string
+	stdout   bool
+	check    bool
+	guard    string
+	number   bool
+	parse    string
+	existing string
+	tolerant bool
+	require  string
+	header   string
+	output   string
+	parseNm  string
+	valuesNm string
+	prefix   string
+	suffix   string
+	names    string
+	initials string
+	report   string
+}
+
+// registerGenFlags defines the code generation flags in the flag set
+func registerGenFlags(fs *flag.FlagSet) *genOptions {
+	opts := &genOptions{}
+	fs.StringVar(&opts.path, "path", "", "output directory path (default: same as source)")
+	fs.StringVar(&opts.guard, "guard", "func", "form of the block preventing unused constant errors: func, var or none")
+	fs.StringVar(&opts.parse, "parse", "map", "parse lookup: map built at init, lazy map built on first use, or switch without a map")
+	fs.StringVar(&opts.existing, "existing-methods", "fail", "generated methods already declared in the package: fail or skip them")
+	fs.StringVar(&opts.require, "require-version", "", "fail if the generator is older than this version, e.g. v1.3.0")
+	fs.StringVar(&opts.output, "output", "", "generated file name or pattern with {{.Type}} or {{.Snake}}, e.g. {{.Type}}.gen.go (default: <type>_enum.go)")
+	fs.StringVar(&opts.parseNm, "parse-name", "", "name of the generated ParseType function, for collisions with existing symbols")
+	fs.StringVar(&opts.valuesNm, "values-name", "", "name of the generated TypeValues variable, for collisions with existing symbols")
+	fs.StringVar(&opts.prefix, "name-prefix", "", "prefix of generated package-level names, except for the type and values")
+	fs.StringVar(&opts.suffix, "name-suffix", "", "suffix of generated package-level names, except for the type and values")
+	fs.StringVar(&opts.names, "names", "", "comma-separated Old:New names of generated identifiers, e.g. StatusIter:AllStatuses")
+	fs.StringVar(&opts.initials, "initialisms", "", "comma-separated domain initialisms for file and exported names, e.g. SKU,VAT for job_sku_enum.go and JobSKU")
+	fs.StringVar(&opts.header, "header", "", "file with a header, e.g. a license banner, prepended to generated Go files")
+	fs.StringVar(&opts.config, "config", "", "JSON config file with option presets applied per type")
+	fs.StringVar(&opts.tmpl, "template", "", "custom template file or directory of *.tmpl files used instead of the embedded one")
+	fs.StringVar(&opts.extras, "template-extra", "", "comma-separated hook=file snippets filling template hooks, e.g. extraMethods=methods.tmpl")
+	fs.StringVar(&opts.tags, "tags", "", "comma-separated build tags to satisfy build constraints of source files")
+	fs.BoolVar(&opts.verbose, "verbose", false, "print to stderr which files and constants were scanned, matched and skipped, and why")
+	fs.BoolVar(&opts.lower, "lower", false, "use lowercase for string representation (e.g., 'active' instead of 'Active')")
+	fs.StringVar(&opts.strCase, "string-case", "", "case of names from multi-word constants: snake, kebab, screaming, camel or as-is (e.g., 'in_progress' for snake)")
+	fs.StringVar(&opts.trim, "trim-prefix", "", "prefix removed after the type name from names and constants, e.g. State for statusStateActive")
+	fs.BoolVar(&opts.getter, "getter", false, "generate GetByID function to retrieve enum by integer value (requires unique IDs)")
+	// optional integrations (all disabled by default to avoid extra deps)
+	fs.BoolVar(&opts.sql, "sql", false, "generate SQL support (database/sql/driver.Valuer and sql.Scanner)")
+	fs.BoolVar(&opts.bson, "bson", false, "generate MongoDB BSON support (MarshalBSONValue/UnmarshalBSONValue)")
+	fs.BoolVar(&opts.yaml, "yaml", false, "generate YAML support (gopkg.in/yaml.v3 Marshaler/Unmarshaler)")
+	fs.BoolVar(&opts.toml, "toml", false, "generate TOML support (github.com/pelletier/go-toml/v2 MarshalTOML/UnmarshalTOML)")
+	fs.BoolVar(&opts.pgx, "pgx", false, "generate pgx v5 support (github.com/jackc/pgx/v5 TextValue/ScanText and type registration)")
+	fs.BoolVar(&opts.flags, "flags", false, "generate bitmask flags type with Has/Set/Clear/Toggle (values must be powers of two)")
+	fs.BoolVar(&opts.jsonmap, "jsonmap", false, "generate MarshalTypeMapJSON helper encoding enum-keyed maps in declaration order")
+	fs.BoolVar(&opts.enumMap, "map", false, "generate TypeMap[V] lookup table backed by fixed-size arrays")
+	fs.BoolVar(&opts.number, "marshal-number", false, "marshal to text, JSON, BSON, YAML and TOML as numeric values instead of names")
+	fs.BoolVar(&opts.tolerant, "tolerant", false, "accept numeric values in addition to names when unmarshaling")
+	fs.BoolVar(&opts.jsonex, "jsonexample", false, "generate TypeJSONExample with the JSON encoding of each value for contract tests")
+	fs.BoolVar(&opts.info, "info", false, "generate TypeInfo descriptors of all values and MarshalAllTypeJSON for enum catalogs in APIs and UIs")
+	fs.BoolVar(&opts.immut, "immutable", false, "generate TypeValues as a function returning a copy, with the values slice kept private")
+	fs.BoolVar(&opts.seq, "seq", false, "generate iter.Seq iterators TypeAll, TypeNamesSeq and TypeEntries over values, names and positions")
+	fs.BoolVar(&opts.sorted, "sorted", false, "generate TypeIterByValue, TypeIterByName and TypeIterReverse iterating in other orders than declaration")
+	fs.BoolVar(&opts.descr, "descriptor", false, "generate TypeDescriptor describing the type and values for generic introspection, e.g. by admin panels")
+	fs.BoolVar(&opts.registry, "registry", false, "register TypeDescriptor in github.com/go-pkgz/enum/registry at init, for lookups of enums by name")
+	fs.BoolVar(&opts.runtime, "runtime", false, "generate thin code delegating parsing, marshaling and iteration to github.com/go-pkgz/enum/enumrt")
+	fs.BoolVar(&opts.generic, "generic", false, "generate parsing, text marshaling and iteration as instantiations of generic helpers of github.com/go-pkgz/enum/enumcore")
+	fs.BoolVar(&opts.format, "formatter", false, "generate fmt.Formatter printing name=value for %+v")
+	fs.BoolVar(&opts.packed, "packed", false, "store all names in a single string indexed by offsets, like stringer, for big enums")
+	fs.BoolVar(&opts.errVals, "error-values", false, "list valid names and aliases in errors of ParseStatus, up to 20 of them")
+	fs.StringVar(&opts.fallback, "fallback", "", "value returned by ParseStatus and unmarshaling for unknown input instead of an error, e.g. unknown")
+	fs.StringVar(&opts.nullVal, "null-value", "", "value SQL NULL is scanned to instead of the zero value, e.g. unknown")
+	fs.BoolVar(&opts.nullStr, "null-strict", false, "scanning SQL NULL returns an error even if there is a zero value")
+	fs.BoolVar(&opts.hideDep, "hide-deprecated", false, "exclude deprecated values from names and completion")
+	fs.StringVar(&opts.i18n, "translations", "", "JSON or YAML file with localized names of the values, keyed by locale")
+	fs.BoolVar(&opts.schema, "jsonschema", false, "write JSON Schema of the enum to <type>_enum.schema.json and a constant")
+	fs.BoolVar(&opts.openAPI, "openapi", false, "write OpenAPI 3 component schema of the enum to <type>_enum.openapi.yaml")
+	fs.StringVar(&opts.tsOut, "ts-out", "", "directory to write TypeScript definitions of the enum to, e.g. a frontend source directory")
+	fs.StringVar(&opts.protoOut, "proto-out", "", "directory to write the protobuf enum declaration of the enum to")
+	fs.BoolVar(&opts.graphql, "graphql", false, "generate MarshalGQL and UnmarshalGQL binding the enum to a gqlgen schema enum")
+	fs.BoolVar(&opts.gqlgen, "gqlgen", false, "write gqlgen.yml models stanza of the enum to type_enum.gqlgen.yml")
+	fs.BoolVar(&opts.ent, "ent", false, "generate Values method and SQL interfaces to use the enum as entgo.io enum field type")
+	fs.BoolVar(&opts.pgEnum, "pg-enum", false, "write Postgres CREATE TYPE and ALTER TYPE statements of the enum to type_enum.postgres.sql")
+	fs.StringVar(&opts.ddl, "ddl", "", "write column definition of the enum for SQL dialect to type_enum.ddl.sql: postgres, mysql or sqlite")
+	fs.BoolVar(&opts.sqlc, "sqlc", false, "write sqlc type overrides of the enum to type_enum.sqlc.yaml and generate SQL support")
+	fs.BoolVar(&opts.redis, "redis", false, "generate MarshalBinary for go-redis and RedisArg/RedisScan for redigo")
+	fs.BoolVar(&opts.null, "null", false, "generate NullStatus wrapper for nullable columns and JSON fields, implies -sql")
+	fs.BoolVar(&opts.set, "set", false, "generate StatusSet bitset type with Add/Remove/Contains/Union/Intersect")
+	fs.BoolVar(&opts.wrap, "wrap", false, "Next and Prev wrap around from the last value to the first one and back")
+	fs.BoolVar(&opts.testHelp, "testhelpers", false, "generate RandomStatus and testing/quick Generate into type_enum_helpers_test.go")
+	fs.BoolVar(&opts.rapid, "rapid", false, "generate RapidStatus pgregory.net/rapid generator with the test helpers, implies -testhelpers")
+	fs.BoolVar(&opts.tests, "tests", false, "generate regression tests of the generated code into type_enum_test.go")
+	fs.BoolVar(&opts.examples, "examples", false, "generate godoc examples of the generated code into type_enum_example_test.go")
+	fs.BoolVar(&opts.crossPkg, "cross-package", false, "generate into another package with -path, the unused constants guard goes to type_enum_bridge.go next to them")
+	fs.BoolVar(&opts.unexport, "unexported", false, "generate unexported names, e.g. parseStatus and statusEnum, for enums internal to the package")
+	fs.BoolVar(&opts.checked, "checked", false, "generate NewTypeFromInt/MustTypeFromInt checked constructors")
+	fs.BoolVar(&opts.complete, "completion", false, "generate flag.Value/pflag.Value methods and TypeCompletionFunc for shell completion")
+	fs.BoolVar(&opts.env, "env", false, "generate TypeFromEnv/MustTypeFromEnv reading the value from an environment variable")
+	fs.BoolVar(&opts.swag, "swag", false, "generate TypeSwaggerEnums constant with the values for swaggo Enums() annotations and enums tags")
+	fs.StringVar(&opts.combine, "combine", "", "write all generated types into one file with this name, e.g. enums.go")
+	fs.StringVar(&opts.combine, "single-file", "", "alias of -combine")
+	fs.BoolVar(&opts.check, "check", false, "verify generated files are up to date without writing them, exit with 1 if not")
+	fs.StringVar(&opts.report, "report", "", "write a JSON report of generated types, files, hashes and durations to this file")
+	fs.BoolVar(&opts.stdout, "stdout", false, "print generated code to stdout instead of writing files")
+	fs.BoolVar(&opts.split, "split", false, "write SQL, BSON, YAML, TOML and pgx support to separate files (e.g. status_enum_sql.go)")
+	fs.StringVar(&opts.splitTag, "split-tags", "", "comma-separated feature:constraint build constraints of -split files, e.g. bson:mongo,yaml:!noyaml")
+	return opts
+}
+
+// newGenerator creates a generator for the type with options from flags applied, and then presets
+// and options configured for the type in the config file, if set.
+// Options from enum: directives on the type declaration are applied on top of them during Parse.
+func newGenerator(typeName string, opts *genOptions) (*generator.Generator, error) {
+	gen, err := generator.New(typeName, opts.path)
+	if err != nil {
+		return nil, err
+	}
+	gen.SetLowerCase(opts.lower)
+	gen.SetStringCase(opts.strCase)
+	gen.SetTrimPrefix(opts.trim)
+	gen.SetGenerateGetter(opts.getter)
+	gen.SetGenerateSQL(opts.sql)
+	gen.SetGenerateBSON(opts.bson)
+	gen.SetGenerateYAML(opts.yaml)
+	gen.SetGenerateTOML(opts.toml)
+	gen.SetGeneratePgx(opts.pgx)
+	gen.SetGeneratePgEnum(opts.pgEnum)
+	gen.SetDDL(opts.ddl)
+	gen.SetSplitFiles(opts.split)
+	for _, pair := range parseTags(opts.splitTag) {
+		if err := gen.SetOption("split-tag", pair); err != nil {
+			return nil, err
+		}
+	}
+	gen.SetGenerateFlags(opts.flags)
+	gen.SetGenerateSet(opts.set)
+	gen.SetWrap(opts.wrap)
+	gen.SetGenerateTestHelpers(opts.testHelp)
+	gen.SetGenerateRapid(opts.rapid)
+	gen.SetGenerateTests(opts.tests)
+	gen.SetGenerateExamples(opts.examples)
+	gen.SetCrossPackage(opts.crossPkg)
+	gen.SetUnexported(opts.unexport)
+	gen.SetGenerateJSONMap(opts.jsonmap)
+	gen.SetGenerateMap(opts.enumMap)
+	gen.SetGenerateChecked(opts.checked)
+	gen.SetGenerateCompletion(opts.complete)
+	gen.SetGenerateEnv(opts.env)
+	gen.SetGenerateSwag(opts.swag)
+	gen.SetGenerateFormatter(opts.format)
+	gen.SetPacked(opts.packed)
+	gen.SetErrorValues(opts.errVals)
+	gen.SetFallback(opts.fallback)
+	gen.SetNullValue(opts.nullVal)
+	gen.SetNullStrict(opts.nullStr)
+	gen.SetHideDeprecated(opts.hideDep)
+	gen.SetTranslations(opts.i18n)
+	gen.SetGenerateJSONSchema(opts.schema)
+	gen.SetGenerateOpenAPI(opts.openAPI)
+	gen.SetTypeScriptOut(opts.tsOut)
+	gen.SetProtoOut(opts.protoOut)
+	gen.SetGenerateGraphQL(opts.graphql)
+	gen.SetGenerateRedis(opts.redis)
+	gen.SetGenerateNull(opts.null)
+	gen.SetGenerateGQLGenConfig(opts.gqlgen)
+	gen.SetGenerateEnt(opts.ent)
+	gen.SetGenerateSQLC(opts.sqlc)
+	gen.SetGenerateJSONExample(opts.jsonex)
+	gen.SetGenerateInfo(opts.info)
+	gen.SetImmutableValues(opts.immut)
+	gen.SetGenerateSeq(opts.seq)
+	gen.SetGenerateSorted(opts.sorted)
+	gen.SetGenerateDescriptor(opts.descr)
+	gen.SetGenerateRegistry(opts.registry)
+	gen.SetRuntime(opts.runtime)
+	gen.SetGeneric(opts.generic)
+	gen.SetGuard(opts.guard)
+	gen.SetMarshalNumber(opts.number)
+	gen.SetParseMode(opts.parse)
+	gen.SetExistingMethods(opts.existing)
+	gen.SetTolerant(opts.tolerant)
+	gen.SetVersion(toolVersion())
+	gen.SetRequireVersion(opts.require)
+	gen.SetHeader(opts.header)
+	gen.SetOutput(opts.output)
+	if opts.parseNm != "" {
+		gen.SetParseName(opts.parseNm)
+	}
+	if opts.valuesNm != "" {
+		gen.SetValuesName(opts.valuesNm)
 	}
+	gen.SetNamePrefix(opts.prefix)
+	gen.SetNameSuffix(opts.suffix)
+	for _, pair := range parseTags(opts.names) {
+		if err := gen.SetOption("name", pair); err != nil {
+			return nil, err
+		}
+	}
+	gen.SetInitialisms(parseTags(opts.initials))
+	gen.SetBuildTags(parseTags(opts.tags))
+	if opts.verbose {
+		gen.SetVerbose(os.Stderr)
+	}
+	gen.SetTemplate(opts.tmpl)
+	extras, err := parseTemplateExtras(opts.extras)
+	if err != nil {
+		return nil, err
+	}
+	gen.SetTemplateExtras(extras)
+	if opts.config != "" {
+		cfg, err := generator.LoadConfig(opts.config)
+		if err != nil {
+			return nil, err
+		}
+		if err := cfg.Apply(gen); err != nil {
+			return nil, err
+		}
+	}
+	return gen, nil
+}
 
-	gen.SetLowerCase(*lowerFlag)
-	gen.SetGenerateGetter(*getterFlag)
-	gen.SetGenerateSQL(*sqlFlag)
-	gen.SetGenerateBSON(*bsonFlag)
-	gen.SetGenerateYAML(*yamlFlag)
+// generate writes the generated code of the type to its file, or to stdout with -stdout
+func generate(gen *generator.Generator, opts *genOptions) error {
+	if opts.stdout {
+		return gen.GenerateTo(os.Stdout)
+	}
+	return gen.Generate()
+}
+
+// parseTags splits comma or space separated build tags, as accepted by the go command
+func parseTags(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' })
+}
+
+// parseTemplateExtras parses comma-separated hook=file pairs of the -template-extra flag
+func parseTemplateExtras(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	res := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		hook, file, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || hook == "" || file == "" {
+			return nil, fmt.Errorf("invalid template extra %q, expected hook=file", pair)
+		}
+		res[hook] = file
+	}
+	return res, nil
+}
 
+// runDescribe prints the value range and contiguity report for one or more types and returns the exit code
+func runDescribe(args []string) int {
+	fs := flag.NewFlagSet("describe", flag.ContinueOnError)
+	typeFlag := fs.String("type", "", "type name (must be lowercase), comma-separated for multiple types")
+	tagsFlag := fs.String("tags", "", "comma-separated build tags to satisfy build constraints of source files")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+
+	for i, typeName := range strings.Split(*typeFlag, ",") {
+		gen, err := generator.New(strings.TrimSpace(typeName), "")
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			return 1
+		}
+		gen.SetBuildTags(parseTags(*tagsFlag))
+		if err := gen.Parse("."); err != nil {
+			fmt.Printf("%v\n", err)
+			return 1
+		}
+		if i > 0 {
+			fmt.Println()
+		}
+		if err := gen.Describe(os.Stdout); err != nil {
+			fmt.Printf("%v\n", err)
+			return 1
+		}
+	}
+	return 0
+}
+
+// runUsages prints locations where the enum type and its values are used across the module
+// and returns the exit code
+func runUsages(args []string) int {
+	fs := flag.NewFlagSet("usages", flag.ContinueOnError)
+	typeFlag := fs.String("type", "", "type name (must be lowercase)")
+	valueFlag := fs.String("value", "", "report only usages of this value, e.g. Blocked, statusBlocked or StatusBlocked")
+	tagsFlag := fs.String("tags", "", "comma-separated build tags to satisfy build constraints of source files")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+
+	gen, err := generator.New(*typeFlag, "")
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	gen.SetBuildTags(parseTags(*tagsFlag))
 	if err := gen.Parse("."); err != nil {
 		fmt.Printf("%v\n", err)
-		osExit(1)
-		return
+		return 1
 	}
 
-	if err := gen.Generate(); err != nil {
+	usages, err := gen.FindUsages(generator.ModuleRoot("."))
+	if err != nil {
 		fmt.Printf("%v\n", err)
-		osExit(1)
-		return
+		return 1
+	}
+
+	wd, _ := os.Getwd()
+	count := 0
+	for _, u := range usages {
+		if *valueFlag != "" && !matchValueName(*typeFlag, u.Value, *valueFlag) {
+			continue
+		}
+		file := u.Pos.Filename
+		if rel, err := filepath.Rel(wd, file); err == nil {
+			file = rel
+		}
+		fmt.Printf("%s:%d:%d: %s: %s\n", file, u.Pos.Line, u.Pos.Column, u.Kind, u.Line)
+		count++
+	}
+	if count == 0 {
+		fmt.Printf("no usages found for %s\n", *typeFlag)
+	}
+	return 0
+}
+
+// runVet reports raw conversions to the enum type and switches missing some of its values across the module
+// and returns the exit code, 1 if any problem is found
+func runVet(args []string) int {
+	fs := flag.NewFlagSet("vet", flag.ContinueOnError)
+	typeFlag := fs.String("type", "", "type name (must be lowercase)")
+	tagsFlag := fs.String("tags", "", "comma-separated build tags to satisfy build constraints of source files")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+
+	gen, err := generator.New(*typeFlag, "")
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	gen.SetBuildTags(parseTags(*tagsFlag))
+	if err := gen.Parse("."); err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+
+	root := generator.ModuleRoot(".")
+	conversions, err := gen.FindConversions(root)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	switches, err := missingCases(root, *typeFlag, parseTags(*tagsFlag))
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+
+	wd, _ := os.Getwd()
+	relPath := func(file string) string {
+		if rel, err := filepath.Rel(wd, file); err == nil {
+			return rel
+		}
+		return file
+	}
+	title := strings.ToUpper((*typeFlag)[:1]) + (*typeFlag)[1:]
+	// the checked constructor exists only for integer-backed enums generated with -checked
+	advice := "Parse" + title
+	if gen.Generated("New" + title + "FromInt") {
+		advice = "New" + title + "FromInt or " + advice
+	}
+	for _, u := range conversions {
+		fmt.Printf("%s:%d:%d: raw conversion to %s bypasses validation, use %s: %s\n",
+			relPath(u.Pos.Filename), u.Pos.Line, u.Pos.Column, *typeFlag, advice, u.Line)
+	}
+	for _, d := range switches {
+		fmt.Printf("%s:%d:%d: %s: %s\n", relPath(d.pos.Filename), d.pos.Line, d.pos.Column, d.message, d.line)
+	}
+	if len(conversions) > 0 || len(switches) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// vetDiagnostic is a diagnostic of the exhaustive analyzer with the source line it points to
+type vetDiagnostic struct {
+	pos     token.Position
+	message string
+	line    string
+}
+
+// missingCases runs the exhaustive analyzer on the packages of the module under root and returns switches
+// on the enum of the type missing some of the values, in the order of files and positions
+func missingCases(root, typ string, tags []string) ([]vetDiagnostic, error) {
+	cfg := &packages.Config{Mode: packages.LoadAllSyntax, Dir: root, Tests: true}
+	if len(tags) > 0 {
+		cfg.BuildFlags = []string{"-tags=" + strings.Join(tags, ",")}
+	}
+	pkgs, err := packages.Load(cfg, "./...")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+	if err := exhaustive.Analyzer.Flags.Set("type", typ); err != nil {
+		return nil, fmt.Errorf("failed to set the type of the analyzer: %w", err)
+	}
+	graph, err := checker.Analyze([]*analysis.Analyzer{exhaustive.Analyzer}, pkgs, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze packages: %w", err)
+	}
+
+	seen := make(map[token.Position]bool) // test variants of a package repeat its diagnostics
+	var res []vetDiagnostic
+	for _, act := range graph.Roots {
+		if len(act.Package.Errors) > 0 {
+			return nil, fmt.Errorf("failed to analyze %s: %w", act.Package.PkgPath, act.Package.Errors[0])
+		}
+		if act.Err != nil {
+			return nil, fmt.Errorf("failed to analyze %s: %w", act.Package.PkgPath, act.Err)
+		}
+		for _, d := range act.Diagnostics {
+			pos := act.Package.Fset.Position(d.Pos)
+			if seen[pos] {
+				continue
+			}
+			seen[pos] = true
+			res = append(res, vetDiagnostic{pos: pos, message: d.Message, line: sourceLine(pos)})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].pos.Filename != res[j].pos.Filename {
+			return res[i].pos.Filename < res[j].pos.Filename
+		}
+		return res[i].pos.Offset < res[j].pos.Offset
+	})
+	return res, nil
+}
+
+// sourceLine returns the trimmed source line at the position, empty if the file can't be read
+func sourceLine(pos token.Position) string {
+	data, err := os.ReadFile(pos.Filename)
+	if err != nil {
+		return ""
+	}
+	lines := strings.Split(string(data), "\n")
+	if pos.Line < 1 || pos.Line > len(lines) {
+		return ""
+	}
+	return strings.TrimSpace(lines[pos.Line-1])
+}
+
+// runSelfUpdate installs the latest or the requested version of the generator with go install
+// and returns the exit code
+func runSelfUpdate(args []string) int {
+	fs := flag.NewFlagSet("self-update", flag.ContinueOnError)
+	versionFlag := fs.String("version", "latest", "version to install, e.g. v1.3.0")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+
+	pkg := "github.com/go-pkgz/enum@" + *versionFlag
+	fmt.Printf("updating enum generator %s to %s\n", toolVersion(), *versionFlag)
+	cmd := execCommand("go", "install", pkg)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Printf("failed to install %s: %v\n", pkg, err)
+		return 1
+	}
+	fmt.Printf("installed %s\n", pkg)
+	return 0
+}
+
+// runSync pushes definitions of the types to a shared manifest, or pulls definitions from it into
+// a Go source file of the current package. Returns the exit code.
+func runSync(args []string) int {
+	fs := flag.NewFlagSet("sync", flag.ContinueOnError)
+	typeFlag := fs.String("type", "", "type names, comma-separated; required for push, limits pulled types")
+	pushFlag := fs.String("push", "", "manifest URL or file to push definitions of the types to")
+	pullFlag := fs.String("pull", "", "manifest URL or file to pull definitions from")
+	outFlag := fs.String("out", "enums_shared.go", "file to write pulled definitions to")
+	tagsFlag := fs.String("tags", "", "comma-separated build tags to satisfy build constraints of source files")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+
+	var types []string
+	if *typeFlag != "" {
+		types = strings.Split(*typeFlag, ",")
+	}
+	var err error
+	switch {
+	case (*pushFlag == "") == (*pullFlag == ""):
+		err = errors.New("exactly one of -push and -pull is required")
+	case *pushFlag != "":
+		err = pushManifest(*pushFlag, types, parseTags(*tagsFlag))
+	default:
+		err = pullManifest(*pullFlag, *outFlag, types)
+	}
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// pushManifest merges definitions of the types in the current directory into the manifest at dst
+func pushManifest(dst string, types, tags []string) error {
+	if len(types) == 0 {
+		return errors.New("type is required to push")
+	}
+	m, err := generator.LoadManifest(dst)
+	if errors.Is(err, generator.ErrManifestNotFound) {
+		m, err = &generator.Manifest{}, nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, typeName := range types {
+		gen, err := generator.New(strings.TrimSpace(typeName), "")
+		if err != nil {
+			return err
+		}
+		gen.SetBuildTags(tags)
+		if err := gen.Parse("."); err != nil {
+			return err
+		}
+		m.Merge(gen.Manifest())
+	}
+	if err := m.Push(dst); err != nil {
+		return err
+	}
+	fmt.Printf("pushed %s to %s\n", strings.Join(types, ", "), dst)
+	return nil
+}
+
+// pullManifest writes definitions of the manifest types at src to the out file in the current directory
+func pullManifest(src, out string, types []string) error {
+	m, err := generator.LoadManifest(src)
+	if err != nil {
+		return err
+	}
+	pkg, err := generator.DirPackage(".")
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := m.WriteSource(&buf, pkg, types); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, buf.Bytes(), 0o644); err != nil { //nolint:gosec // generated source file
+		return fmt.Errorf("failed to write definitions: %w", err)
+	}
+	fmt.Printf("pulled definitions from %s to %s, run go generate to regenerate the enums\n", src, out)
+	return nil
+}
+
+// runImportProto writes Go definitions of enums declared in a .proto file to a file in the current
+// directory and generates the enum code for them. Returns the exit code.
+func runImportProto(args []string) int {
+	fs := flag.NewFlagSet("import-proto", flag.ContinueOnError)
+	inFlag := fs.String("in", "", ".proto file to import enums from")
+	typeFlag := fs.String("type", "", "type names to import, comma-separated, all enums of the file if empty")
+	outFlag := fs.String("out", "enums_proto.go", "file to write definitions of the enums to")
+	opts := registerGenFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+	if *inFlag == "" {
+		fmt.Printf("-in is required\n")
+		return 1
+	}
+
+	if err := importProto(*inFlag, *outFlag, *typeFlag, opts); err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// importProto writes definitions of the enums in the proto file to the out file and generates them
+func importProto(src, out, typeList string, opts *genOptions) error {
+	m, err := generator.LoadProto(src)
+	if err != nil {
+		return err
+	}
+	var types []string
+	if typeList != "" {
+		types = strings.Split(typeList, ",")
+	}
+	pkg, err := generator.DirPackage(".")
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := m.WriteSource(&buf, pkg, types); err != nil {
+		return err
+	}
+	if err := os.WriteFile(out, buf.Bytes(), 0o644); err != nil { //nolint:gosec // generated source file
+		return fmt.Errorf("failed to write definitions: %w", err)
+	}
+
+	if len(types) == 0 {
+		for _, tm := range m.Types {
+			types = append(types, tm.Type)
+		}
+	}
+	gens := make([]*generator.Generator, 0, len(types))
+	for _, typeName := range types {
+		gen, err := newGenerator(typeName, opts)
+		if err != nil {
+			return err
+		}
+		if err := gen.Parse("."); err != nil {
+			return err
+		}
+		gens = append(gens, gen)
+	}
+	if _, err := generateAll(gens, opts); err != nil {
+		return err
+	}
+	fmt.Printf("imported %s from %s to %s\n", strings.Join(types, ", "), src, out)
+	return nil
+}
+
+// runScan runs the enum generator for //go:generate directives found in the packages matching the patterns,
+// "./..." by default, in-process instead of with go generate, and prints a summary. With -dry-run directives
+// are only validated. Returns the exit code, 1 if any directive is malformed or fails.
+func runScan(args []string) int {
+	fs := flag.NewFlagSet("scan", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "validate directives without generating code")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	var directives []generator.GenerateDirective
+	for _, pattern := range patterns {
+		dir, recursive := strings.CutSuffix(pattern, "...")
+		found, err := generator.FindGenerateDirectives(filepath.Clean(cmp.Or(dir, ".")), recursive)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			return 1
+		}
+		directives = append(directives, found...)
+	}
+
+	failed := 0
+	for _, d := range directives {
+		err := d.Err
+		if err == nil {
+			err = runDirective(d, *dryRun)
+		}
+		if err != nil {
+			failed++
+			fmt.Printf("%s:%d: %s: %v\n", d.Pos.Filename, d.Pos.Line, d.Line, err)
+			continue
+		}
+		fmt.Printf("%s:%d: %s: ok\n", d.Pos.Filename, d.Pos.Line, d.Line)
+	}
+	fmt.Printf("%d directive(s), %d failed\n", len(directives), failed)
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// runDirective parses the arguments of the directive like the generator does and, unless dryRun is set,
+// generates the code in the directory of the directive, as go generate runs it there. Directives running
+// the import-proto and sync subcommands are run as they are, without validation of their flags.
+func runDirective(d generator.GenerateDirective, dryRun bool) error {
+	if len(d.Args) > 0 && (d.Args[0] == "import-proto" || d.Args[0] == "sync") {
+		if dryRun {
+			return nil
+		}
+		run := map[string]func([]string) int{"import-proto": runImportProto, "sync": runSync}[d.Args[0]]
+		return runInDir(filepath.Dir(d.Pos.Filename), func() int { return run(d.Args[1:]) })
+	}
+
+	fs := flag.NewFlagSet("enum", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	typeFlag := fs.String("type", "", "type name (must be lowercase), comma-separated for multiple types")
+	allFlag := fs.Bool("all", false, "generate all types annotated with an enum: directive in the current directory")
+	opts := registerGenFlags(fs)
+	if err := fs.Parse(d.Args); err != nil {
+		return err
+	}
+	switch {
+	case fs.NArg() > 0:
+		return fmt.Errorf("unexpected arguments %s", strings.Join(fs.Args(), " "))
+	case *typeFlag == "" && !*allFlag:
+		return errors.New("-type or -all is required")
+	case dryRun:
+		return nil
+	}
+	return runInDir(filepath.Dir(d.Pos.Filename), func() int { return runGeneration(*typeFlag, *allFlag, opts, func() {}) })
+}
+
+// runInDir calls run in the directory and changes back to the current one, it fails if run returns
+// a non-zero exit code, after printing the error
+func runInDir(dir string, run func() int) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := os.Chdir(dir); err != nil {
+		return err
+	}
+	defer os.Chdir(wd) //nolint:errcheck // the directory existed before
+	if code := run(); code != 0 {
+		return fmt.Errorf("failed with exit code %d", code)
+	}
+	return nil
+}
+
+// runRename renames an enum value in the source, optionally rewrites references across the module
+// and regenerates the enum code. Returns the exit code.
+func runRename(args []string) int {
+	fs := flag.NewFlagSet("rename", flag.ContinueOnError)
+	typeFlag := fs.String("type", "", "type name (must be lowercase)")
+	fromFlag := fs.String("from", "", "current value name, e.g. Blocked")
+	toFlag := fs.String("to", "", "new value name, e.g. Suspended")
+	refsFlag := fs.Bool("refs", false, "rewrite references to the public constant across the module")
+	opts := registerGenFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 1
+	}
+	if *fromFlag == "" || *toFlag == "" {
+		fmt.Printf("both -from and -to are required\n")
+		return 1
+	}
+	if opts.combine != "" {
+		fmt.Printf("-combine is not supported by rename, regenerate the combined file after renaming\n")
+		return 1
+	}
+
+	gen, err := newGenerator(*typeFlag, opts)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	if err := gen.Parse("."); err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+
+	root := ""
+	if *refsFlag {
+		root = generator.ModuleRoot(".")
+	}
+	changed, err := gen.RenameValue(*fromFlag, *toFlag, root)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	for _, file := range changed {
+		fmt.Printf("updated %s\n", file)
+	}
+
+	if err := generate(gen, opts); err != nil {
+		fmt.Printf("%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// matchValueName checks if the private constant name matches the value given by the user,
+// which may be the bare name (Blocked), the private (statusBlocked) or the public name (StatusBlocked)
+func matchValueName(typeName, privateName, value string) bool {
+	if privateName == "" {
+		return false
 	}
+	bare := strings.TrimPrefix(privateName, typeName)
+	return strings.EqualFold(value, bare) || strings.EqualFold(value, privateName)
 }
 
 func showUsage() {
-	fmt.Printf("usage: enum [flags]\n\n")
+	fmt.Printf("usage: enum [flags]\n")
+	fmt.Printf("       enum describe -type name[,name...] [-tags tags]\n")
+	fmt.Printf("       enum usages -type name [-value name] [-tags tags]\n")
+	fmt.Printf("       enum rename -type name -from name -to name [-refs] [flags]\n")
+	fmt.Printf("       enum vet -type name [-tags tags]\n")
+	fmt.Printf("       enum self-update [-version version]\n")
+	fmt.Printf("       enum sync -type name[,name...] -push url|file [-tags tags]\n")
+	fmt.Printf("       enum sync -pull url|file [-type name[,name...]] [-out file]\n")
+	fmt.Printf("       enum import-proto -in file.proto [-type name[,name...]] [-out file] [flags]\n")
+	fmt.Printf("       enum scan [-dry-run] [packages]\n\n")
 	fmt.Printf("Flags:\n")
 	flag.PrintDefaults()
 }
@@ -1,9 +1,13 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
+	"io"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -244,4 +248,748 @@ const (
 		main()
 		assert.Equal(t, 1, exitCode)
 	})
+
+	t.Run("describe", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		err = os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(`
+package test
+type status uint8
+const (
+	statusUnknown status = iota
+	statusActive
+)
+`), 0o644)
+		require.NoError(t, err)
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "describe", "-type", "status"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		_, err = os.Stat(filepath.Join(tmpDir, "status_enum.go"))
+		assert.True(t, os.IsNotExist(err), "describe should not generate code")
+
+		os.Args = []string{"app", "describe", "-type", "missing"}
+		main()
+		assert.Equal(t, 1, exitCode)
+	})
+
+	t.Run("usages", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n"), 0o644))
+		err = os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(`
+package test
+type status uint8
+const (
+	statusUnknown status = iota
+	statusActive
+)
+func isActive(s status) bool { return s == statusActive }
+`), 0o644)
+		require.NoError(t, err)
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "usages", "-type", "status", "-value", "Active"}
+		main()
+		assert.Equal(t, 0, exitCode)
+
+		os.Args = []string{"app", "usages", "-type", "Status"}
+		main()
+		assert.Equal(t, 1, exitCode)
+	})
+
+	t.Run("all annotated types", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		err = os.WriteFile(filepath.Join(tmpDir, "enums.go"), []byte(`
+package test
+
+//enum: lower
+type status uint8
+
+//enum: getter
+type priority int
+
+type plain int
+
+const (
+	statusUnknown status = iota
+	statusActive
+)
+
+const (
+	priorityLow priority = iota
+	priorityHigh
+)
+`), 0o644)
+		require.NoError(t, err)
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "-all"}
+		main()
+		assert.Equal(t, 0, exitCode)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `name: "active"`)
+
+		content, err = os.ReadFile(filepath.Join(tmpDir, "priority_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func GetPriorityByID(")
+		assert.NoFileExists(t, filepath.Join(tmpDir, "plain_enum.go"))
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n"), 0o644))
+		err = os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(`
+package test
+type status uint8
+const (
+	statusUnknown status = iota
+	statusBlocked
+)
+var current = StatusBlocked
+`), 0o644)
+		require.NoError(t, err)
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "rename", "-type", "status", "-from", "Blocked", "-to", "Suspended", "-refs", "-lower"}
+		main()
+		assert.Equal(t, 0, exitCode)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "statusSuspended")
+		assert.Contains(t, string(content), "var current = StatusSuspended")
+
+		content, err = os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `name: "suspended"`)
+
+		os.Args = []string{"app", "rename", "-type", "status", "-from", "Blocked"}
+		main()
+		assert.Equal(t, 1, exitCode)
+	})
+
+	t.Run("build tags", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		files := map[string]string{
+			"status.go":       "package test\n\ntype status uint8\n\nconst statusUnknown status = 0\n",
+			"status_pro.go":   "//go:build pro\n\npackage test\n\nconst statusAudit status = 1\n",
+			"status_basic.go": "//go:build !pro\n\npackage test\n\nconst statusBasic status = 1\n",
+		}
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o644))
+		}
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "-type", "status", "-tags", "pro,other"}
+		main()
+		assert.Equal(t, 0, exitCode)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "StatusAudit")
+		assert.NotContains(t, string(content), "StatusBasic")
+	})
+
+	t.Run("config presets", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		files := map[string]string{
+			"status.go": "package test\n\ntype status uint8\n\nconst (\n\tstatusUnknown status = iota\n\tstatusActive\n)\n",
+			"enum.json": `{"presets": {"db-enum": ["sql", "getter"]}, "types": {"status": ["db-enum"]}}`,
+			"bad.json":  `{"presets": {"db-enum": ["sql-int"]}}`,
+		}
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o644))
+		}
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "-type", "status", "-lower", "-config", "enum.json"}
+		main()
+		assert.Equal(t, 0, exitCode)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func GetStatusByID(")
+		assert.Contains(t, string(content), "func (e Status) Value() (driver.Value, error)")
+		assert.Contains(t, string(content), `name: "active"`, "flags are kept")
+
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		os.Args = []string{"app", "-type", "status", "-config", "bad.json"}
+		main()
+		assert.Equal(t, 1, exitCode)
+	})
+
+	t.Run("template extra", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		files := map[string]string{
+			"status.go":    "package test\n\ntype status uint8\n\nconst (\n\tstatusUnknown status = iota\n\tstatusActive\n)\n",
+			"methods.tmpl": "func (e {{.Type | title}}) IsActive() bool { return e == StatusActive }\n",
+		}
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0o644))
+		}
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "-type", "status", "-template-extra", "extraMethods=methods.tmpl"}
+		main()
+		assert.Equal(t, 0, exitCode)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "func (e Status) IsActive() bool { return e == StatusActive }")
+
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		os.Args = []string{"app", "-type", "status", "-template-extra", "methods.tmpl"}
+		main()
+		assert.Equal(t, 1, exitCode, "hook name is required")
+	})
+
+	t.Run("combine", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		src := "package test\n\ntype status uint8\n\nconst statusActive status = 1\n\ntype role uint8\n\nconst roleAdmin role = 1\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "-type", "status,role", "-combine", "enums.go"}
+		main()
+		assert.Equal(t, 0, exitCode)
+
+		content, err := os.ReadFile(filepath.Join(tmpDir, "enums.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "type Status struct")
+		assert.Contains(t, string(content), "type Role struct")
+		assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum.go"))
+
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		os.Args = []string{"app", "-type", "status,role", "-single-file", "enums_gen.go"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		content, err = os.ReadFile(filepath.Join(tmpDir, "enums_gen.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "type Status struct")
+		assert.Contains(t, string(content), "type Role struct")
+
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		os.Args = []string{"app", "-type", "status,role", "-combine", "-lower"}
+		main()
+		assert.Equal(t, 1, exitCode)
+		assert.NoFileExists(t, filepath.Join(tmpDir, "-lower"))
+	})
+
+	t.Run("output", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		src := "package test\n\ntype status uint8\n\nconst statusActive status = 1\n\ntype role uint8\n\nconst roleAdmin role = 1\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "-type", "status,role", "-output", "{{.Type}}.gen.go"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		assert.FileExists(t, filepath.Join(tmpDir, "status.gen.go"))
+		assert.FileExists(t, filepath.Join(tmpDir, "role.gen.go"))
+
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		os.Args = []string{"app", "-type", "status,role", "-output", "enums.go"}
+		main()
+		assert.Equal(t, 1, exitCode, "explicit name is the same for all types")
+		assert.NoFileExists(t, filepath.Join(tmpDir, "enums.go"))
+	})
+
+	t.Run("stdout", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+
+		origArgs, origStdout := os.Args, os.Stdout
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args, os.Stdout = origArgs, origStdout
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		src := "package test\n\ntype status uint8\n\nconst statusActive status = 1\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+		os.Stdout = w
+		os.Args = []string{"app", "-type", "status", "-stdout"}
+		main()
+		require.NoError(t, w.Close())
+		os.Stdout = origStdout
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, exitCode)
+		assert.Contains(t, string(out), "type Status struct")
+		assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum.go"))
+	})
+
+	t.Run("check", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		src := "package test\n\ntype status uint8\n\nconst statusActive status = 1\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+		run := func(args ...string) {
+			exitCode = 0
+			flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+			os.Args = append([]string{"app"}, args...)
+			main()
+		}
+
+		run("-type", "status", "-check")
+		assert.Equal(t, 1, exitCode, "missing file")
+		assert.NoFileExists(t, filepath.Join(tmpDir, "status_enum.go"))
+
+		run("-type", "status")
+		assert.Equal(t, 0, exitCode)
+		run("-type", "status", "-check")
+		assert.Equal(t, 0, exitCode, "up to date")
+
+		run("-type", "status", "-lower", "-check")
+		assert.Equal(t, 1, exitCode, "options changed")
+	})
+
+	t.Run("vet", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module test\n\ngo 1.24\n"), 0o644))
+		src := "package test\n\ntype status uint8\n\nconst (\n\tstatusUnknown status = iota\n\tstatusActive\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "vet", "-type", "status"}
+		main()
+		assert.Equal(t, 0, exitCode, "no conversions")
+
+		vet := func() string {
+			origStdout := os.Stdout
+			defer func() { os.Stdout = origStdout }()
+			r, w, err := os.Pipe()
+			require.NoError(t, err)
+			os.Stdout = w
+			os.Args = []string{"app", "vet", "-type", "status"}
+			main()
+			require.NoError(t, w.Close())
+			out, err := io.ReadAll(r)
+			require.NoError(t, err)
+			return string(out)
+		}
+		conv := "package test\n\nfunc load(v int) status { return status(v) }\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "load.go"), []byte(conv), 0o644))
+		assert.Contains(t, vet(), "raw conversion to status bypasses validation, use ParseStatus: func load(v int) status")
+		assert.Equal(t, 1, exitCode, "raw conversion reported")
+
+		// the checked constructor is suggested once it's generated
+		exitCode = 0
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		os.Args = []string{"app", "-type", "status", "-checked"}
+		main()
+		require.Equal(t, 0, exitCode)
+		assert.Contains(t, vet(), "use NewStatusFromInt or ParseStatus: func load(v int) status")
+		require.NoError(t, os.Remove(filepath.Join(tmpDir, "status_enum.go")))
+
+		require.NoError(t, os.Remove(filepath.Join(tmpDir, "load.go")))
+		sw := "package test\n\nfunc active(s status) bool {\n\tswitch s {\n\tcase statusActive:\n\t\treturn true\n\t}\n\treturn false\n}\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "active.go"), []byte(sw), 0o644))
+		exitCode = 0
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		os.Args = []string{"app", "-type", "status"}
+		main()
+		require.Equal(t, 0, exitCode)
+		assert.Contains(t, vet(), "active.go:4:2: switch on status misses StatusUnknown, add cases or a default clause: switch s {")
+		assert.Equal(t, 1, exitCode, "switch missing statusUnknown reported")
+
+		os.Args = []string{"app", "vet", "-type", "missing"}
+		main()
+		assert.Equal(t, 1, exitCode)
+	})
+
+	t.Run("require version", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		src := "package test\n\n// enum: require-version=v0.1.0\ntype status uint8\n\nconst statusActive status = 1\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+		run := func(args ...string) {
+			exitCode = 0
+			flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+			os.Args = append([]string{"app"}, args...)
+			main()
+		}
+
+		run("-type", "status")
+		assert.Equal(t, 0, exitCode, "development build satisfies any version")
+		assert.FileExists(t, filepath.Join(tmpDir, "status_enum.go"))
+
+		run("-type", "status", "-require-version", "latest")
+		assert.Equal(t, 0, exitCode, "directive overrides the flag")
+
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "status.go"), []byte(strings.Replace(src, "v0.1.0", "latest", 1)), 0o644))
+		run("-type", "status")
+		assert.Equal(t, 1, exitCode, "invalid version")
+	})
+
+	t.Run("report", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		tmpDir := t.TempDir()
+		src := "package test\n\ntype status uint8\n\nconst statusActive status = 1\n\ntype role uint8\n\nconst roleAdmin role = 1\n"
+		require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "types.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(tmpDir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+		run := func(args ...string) runReport {
+			exitCode = 0
+			flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+			os.Args = append([]string{"app"}, args...)
+			main()
+			data, err := os.ReadFile(filepath.Join(tmpDir, "report.json"))
+			require.NoError(t, err)
+			var rep runReport
+			require.NoError(t, json.Unmarshal(data, &rep))
+			return rep
+		}
+
+		rep := run("-type", "status,role", "-report", "report.json")
+		assert.Equal(t, 0, exitCode)
+		assert.Equal(t, toolVersion(), rep.Generator)
+		assert.Empty(t, rep.Error)
+		require.Len(t, rep.Units, 2)
+		assert.Equal(t, []string{"status"}, rep.Units[0].Types)
+		assert.Equal(t, []string{"role"}, rep.Units[1].Types)
+		require.Len(t, rep.Units[0].Files, 1)
+		assert.Equal(t, "status_enum.go", rep.Units[0].Files[0].Path)
+		assert.Len(t, rep.Units[0].Files[0].SHA256, 64)
+		assert.True(t, rep.Units[0].Files[0].Changed)
+
+		rep = run("-type", "status,role", "-combine", "enums.go", "-report", "report.json")
+		assert.Equal(t, 0, exitCode)
+		require.Len(t, rep.Units, 1)
+		assert.Equal(t, []string{"status", "role"}, rep.Units[0].Types)
+		var paths []string
+		for _, f := range rep.Units[0].Files {
+			paths = append(paths, f.Path)
+		}
+		assert.Equal(t, []string{"enums.go", "status_enum.go", "role_enum.go"}, paths)
+
+		rep = run("-type", "status", "-guard", "lambda", "-report", "report.json")
+		assert.Equal(t, 1, exitCode)
+		assert.Equal(t, `invalid guard "lambda", expected func, var or none`, rep.Error)
+	})
+
+	t.Run("sync", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		registry := filepath.Join(t.TempDir(), "enums.json")
+		producer, consumer := t.TempDir(), t.TempDir()
+		src := "package billing\n\ntype status uint8\n\nconst (\n\tstatusUnknown status = iota\n\tstatusPaid // enum:alias=settled\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(producer, "status.go"), []byte(src), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(consumer, "doc.go"), []byte("package orders\n"), 0o644))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		require.NoError(t, os.Chdir(producer))
+		os.Args = []string{"app", "sync", "-type", "status", "-push", registry}
+		main()
+		assert.Equal(t, 0, exitCode)
+		assert.FileExists(t, registry)
+
+		require.NoError(t, os.Chdir(consumer))
+		os.Args = []string{"app", "sync", "-pull", registry}
+		main()
+		assert.Equal(t, 0, exitCode)
+		content, err := os.ReadFile(filepath.Join(consumer, "enums_shared.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "package orders\n")
+		assert.Contains(t, string(content), "statusPaid    status = 1 // enum:alias=settled\n")
+
+		os.Args = []string{"app", "sync", "-pull", registry, "-push", registry}
+		main()
+		assert.Equal(t, 1, exitCode, "both directions")
+
+		os.Args = []string{"app", "sync", "-pull", registry, "-type", "color"}
+		main()
+		assert.Equal(t, 1, exitCode, "unknown type")
+	})
+
+	t.Run("import-proto", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		dir := t.TempDir()
+		proto := "syntax = \"proto3\";\n\nenum JobStatus {\n  JOB_STATUS_UNSPECIFIED = 0;\n  JOB_STATUS_DONE = 2;\n}\n" +
+			"enum Priority {\n  PRIORITY_LOW = 0;\n}\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "jobs.proto"), []byte(proto), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "doc.go"), []byte("package jobs\n"), 0o644))
+		require.NoError(t, os.Chdir(dir))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "import-proto", "-in", "jobs.proto", "-type", "jobStatus", "-lower"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		content, err := os.ReadFile(filepath.Join(dir, "enums_proto.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), "package jobs\n")
+		assert.Contains(t, string(content), "jobStatusDone        jobStatus = 2\n")
+		assert.NotContains(t, string(content), "priority")
+		generated, err := os.ReadFile(filepath.Join(dir, "job_status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(generated), "JobStatusDone        = JobStatus{name: \"done\", value: 2}")
+		assert.NoFileExists(t, filepath.Join(dir, "priority_enum.go"))
+
+		os.Args = []string{"app", "import-proto", "-in", "jobs.proto"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		assert.FileExists(t, filepath.Join(dir, "priority_enum.go"))
+
+		os.Args = []string{"app", "import-proto"}
+		main()
+		assert.Equal(t, 1, exitCode, "no input")
+
+		os.Args = []string{"app", "import-proto", "-in", "missing.proto"}
+		main()
+		assert.Equal(t, 1, exitCode, "missing input")
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		origArgs := os.Args
+		origWd, err := os.Getwd()
+		require.NoError(t, err)
+		defer func() {
+			os.Args = origArgs
+			require.NoError(t, os.Chdir(origWd))
+		}()
+
+		root := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(root, "go.mod"), []byte("module test\n"), 0o644))
+		require.NoError(t, os.Mkdir(filepath.Join(root, "jobs"), 0o755))
+		src := "package jobs\n\n//go:generate go run github.com/go-pkgz/enum@latest -type status -lower\n\n" +
+			"type status uint8\n\nconst (\n\tstatusUnknown status = iota\n\tstatusActive\n)\n"
+		require.NoError(t, os.WriteFile(filepath.Join(root, "jobs", "status.go"), []byte(src), 0o644))
+		require.NoError(t, os.Chdir(root))
+
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "scan", "-dry-run"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		assert.NoFileExists(t, filepath.Join(root, "jobs", "status_enum.go"), "dry run doesn't generate")
+
+		os.Args = []string{"app", "scan"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		content, err := os.ReadFile(filepath.Join(root, "jobs", "status_enum.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(content), `StatusActive  = Status{name: "active", value: 1}`)
+		wd, err := os.Getwd()
+		require.NoError(t, err)
+		wd, err = filepath.EvalSymlinks(wd)
+		require.NoError(t, err)
+		want, err := filepath.EvalSymlinks(root)
+		require.NoError(t, err)
+		assert.Equal(t, want, wd, "working directory is restored")
+
+		bad := "package jobs\n\n//go:generate enum -type role -unknown\n"
+		require.NoError(t, os.WriteFile(filepath.Join(root, "jobs", "role.go"), []byte(bad), 0o644))
+		os.Args = []string{"app", "scan", "./jobs/..."}
+		main()
+		assert.Equal(t, 1, exitCode, "malformed directive")
+	})
+
+	t.Run("self-update", func(t *testing.T) {
+		origArgs, origExec := os.Args, execCommand
+		defer func() { os.Args, execCommand = origArgs, origExec }()
+
+		var cmdArgs []string
+		execCommand = func(name string, args ...string) *exec.Cmd {
+			cmdArgs = append([]string{name}, args...)
+			return exec.Command("true")
+		}
+		var exitCode int
+		osExit = func(code int) { exitCode = code }
+
+		os.Args = []string{"app", "self-update"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		assert.Equal(t, []string{"go", "install", "github.com/go-pkgz/enum@latest"}, cmdArgs)
+
+		os.Args = []string{"app", "self-update", "-version", "v1.3.0"}
+		main()
+		assert.Equal(t, 0, exitCode)
+		assert.Equal(t, []string{"go", "install", "github.com/go-pkgz/enum@v1.3.0"}, cmdArgs)
+
+		execCommand = func(string, ...string) *exec.Cmd { return exec.Command("false") }
+		main()
+		assert.Equal(t, 1, exitCode, "install failure")
+	})
 }
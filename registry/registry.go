@@ -0,0 +1,87 @@
+// Package registry keeps enums generated with the -registry flag, so applications can look up any of them
+// by name at runtime, list the values and parse them dynamically, e.g. in admin tools and config validation.
+// Generated code registers each enum in its init function, under the import path of the package and the type
+// name, e.g. "example.com/app/order.Status".
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Enum describes a generated enum, implemented by the <Type>EnumDescriptor of the generated code.
+// Values are addressed by position in declaration order, from 0 to Len()-1.
+type Enum interface {
+	TypeName() string            // name of the enum type, e.g. "Status"
+	UnderlyingType() string      // type of the raw values, e.g. "uint8"
+	Len() int                    // number of values
+	Name(i int) string           // name of the value as marshaled
+	Value(i int) any             // the enum value, e.g. StatusActive
+	Raw(i int) any               // underlying value, e.g. uint8(1)
+	Aliases(i int) []string      // aliases accepted by parsing
+	Parse(s string) (any, error) // the enum value for the name or alias, as ParseStatus
+}
+
+var (
+	mu    sync.RWMutex
+	enums = make(map[string]Enum)
+)
+
+// Register adds the enum under the name, the import path of its package and the type name.
+// It panics if the name is empty or already registered, like the same enum generated twice.
+func Register(name string, e Enum) {
+	mu.Lock()
+	defer mu.Unlock()
+	if name == "" || e == nil {
+		panic("registry: Register with empty name or nil enum")
+	}
+	if _, dup := enums[name]; dup {
+		panic("registry: Register called twice for enum " + name)
+	}
+	enums[name] = e
+}
+
+// Lookup returns the enum registered with the name. Besides the full name, e.g. "example.com/app/order.Status",
+// it accepts the package name with the type, "order.Status", and the type name alone, "Status", as long as only
+// one registered enum matches them.
+func Lookup(name string) (Enum, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	if e, ok := enums[name]; ok {
+		return e, true
+	}
+	var found Enum
+	for full, e := range enums {
+		if !strings.HasSuffix(full, "/"+name) && full[strings.LastIndex(full, ".")+1:] != name {
+			continue
+		}
+		if found != nil {
+			return nil, false // ambiguous
+		}
+		found = e
+	}
+	return found, found != nil
+}
+
+// Names returns the full names of all registered enums, sorted
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	res := make([]string, 0, len(enums))
+	for name := range enums {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// Parse returns the value of the enum registered with the name for the string, see Lookup for the names
+func Parse(name, s string) (any, error) {
+	e, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("enum %s is not registered or ambiguous", name)
+	}
+	return e.Parse(s)
+}
@@ -0,0 +1,94 @@
+package registry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEnum struct {
+	name   string
+	values []string
+}
+
+func (f fakeEnum) TypeName() string       { return f.name }
+func (f fakeEnum) UnderlyingType() string { return "int" }
+func (f fakeEnum) Len() int               { return len(f.values) }
+func (f fakeEnum) Name(i int) string      { return f.values[i] }
+func (f fakeEnum) Value(i int) any        { return f.values[i] }
+func (f fakeEnum) Raw(i int) any          { return i }
+func (f fakeEnum) Aliases(int) []string   { return nil }
+
+func (f fakeEnum) Parse(s string) (any, error) {
+	for _, v := range f.values {
+		if v == s {
+			return v, nil
+		}
+	}
+	return nil, errors.New("invalid " + f.name + ": " + s)
+}
+
+// reset empties the registry for the test and after it
+func reset(t *testing.T) {
+	empty := func() {
+		mu.Lock()
+		enums = make(map[string]Enum)
+		mu.Unlock()
+	}
+	empty()
+	t.Cleanup(empty)
+}
+
+func TestRegister(t *testing.T) {
+	reset(t)
+	status := fakeEnum{name: "Status", values: []string{"active", "inactive"}}
+	Register("example.com/app/order.Status", status)
+	Register("example.com/app/user.Status", fakeEnum{name: "Status", values: []string{"new"}})
+	Register("example.com/app/order.Kind", fakeEnum{name: "Kind", values: []string{"retail"}})
+
+	assert.Equal(t, []string{"example.com/app/order.Kind", "example.com/app/order.Status", "example.com/app/user.Status"},
+		Names())
+	assert.PanicsWithValue(t, "registry: Register called twice for enum example.com/app/order.Status", func() {
+		Register("example.com/app/order.Status", status)
+	})
+	assert.Panics(t, func() { Register("", status) })
+	assert.Panics(t, func() { Register("example.com/app/order.Priority", nil) })
+
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"example.com/app/order.Status", true},
+		{"order.Status", true},
+		{"Kind", true},
+		{"order.Kind", true},
+		{"Status", false}, // ambiguous
+		{"app/order.Status", true},
+		{"der.Status", false},
+		{"Priority", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := Lookup(tt.name)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.ok, e != nil)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	reset(t)
+	Register("example.com/app/order.Status", fakeEnum{name: "Status", values: []string{"active", "inactive"}})
+
+	v, err := Parse("order.Status", "inactive")
+	require.NoError(t, err)
+	assert.Equal(t, "inactive", v)
+
+	_, err = Parse("order.Status", "unknown")
+	require.EqualError(t, err, "invalid Status: unknown")
+
+	_, err = Parse("order.Kind", "retail")
+	require.EqualError(t, err, "enum order.Kind is not registered or ambiguous")
+}